@@ -23,6 +23,8 @@ declare -a examples=(
   "unknown_operator.beef:Unknown Operator (BOOLEAN + BOOLEAN)"
   "invalid_negation.beef:Invalid Negation (-BOOLEAN)"
   "string_type_mismatch.beef:String Type Mismatch (STRING + INTEGER)"
+  "unknown_member.beef:Unknown Module Member (io.preahc)"
+  "division_by_zero.beef:Division by Zero (INTEGER / 0)"
 )
 
 for example in "${examples[@]}"; do
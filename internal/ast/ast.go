@@ -40,6 +40,15 @@ type IntegerLiteral struct {
 func (il *IntegerLiteral) expressionNode()      {}
 func (il *IntegerLiteral) TokenLiteral() string { return il.Token.Literal }
 
+// FloatLiteral represents a floating point literal like 3.14
+type FloatLiteral struct {
+	Token token.Token
+	Value float64
+}
+
+func (fl *FloatLiteral) expressionNode()      {}
+func (fl *FloatLiteral) TokenLiteral() string { return fl.Token.Literal }
+
 // BooleanLiteral represents a boolean literal like true or false
 type BooleanLiteral struct {
 	Token token.Token
@@ -58,6 +67,16 @@ type StringLiteral struct {
 func (sl *StringLiteral) expressionNode()      {}
 func (sl *StringLiteral) TokenLiteral() string { return sl.Token.Literal }
 
+// InterpolatedString represents a string with embedded expressions like "total: {sum + 1}".
+// Parts alternate between *StringLiteral text and the parsed expressions.
+type InterpolatedString struct {
+	Token token.Token
+	Parts []Expression
+}
+
+func (is *InterpolatedString) expressionNode()      {}
+func (is *InterpolatedString) TokenLiteral() string { return is.Token.Literal }
+
 // Identifier represents a variable or function name
 type Identifier struct {
 	Token token.Token
@@ -98,6 +117,16 @@ type VariableDeclaration struct {
 func (vd *VariableDeclaration) statementNode()       {}
 func (vd *VariableDeclaration) TokenLiteral() string { return vd.Token.Literal }
 
+// ConstantDeclaration represents: pack x = 42 (immutable binding)
+type ConstantDeclaration struct {
+	Token token.Token // The 'pack' token
+	Name  *Identifier
+	Value Expression
+}
+
+func (cd *ConstantDeclaration) statementNode()       {}
+func (cd *ConstantDeclaration) TokenLiteral() string { return cd.Token.Literal }
+
 // AssignmentStatement represents: x = 42 (reassignment, no prep keyword)
 type AssignmentStatement struct {
 	Token token.Token // The identifier token
@@ -117,17 +146,28 @@ type ReturnStatement struct {
 func (rs *ReturnStatement) statementNode()       {}
 func (rs *ReturnStatement) TokenLiteral() string { return rs.Token.Literal }
 
-// IfStatement represents: if condition: consequence beef else alternative beef
+// IfStatement represents: if condition: consequence else if condition: ... else: alternative beef
+// The whole chain shares a single terminating beef.
 type IfStatement struct {
 	Token       token.Token
 	Condition   Expression
 	Consequence *BlockStatement
-	Alternative *BlockStatement
+	ElseIfs     []*ElseIfClause // optional 'else if' branches, tried in order
+	Alternative *BlockStatement // optional final 'else' block
 }
 
 func (is *IfStatement) statementNode()       {}
 func (is *IfStatement) TokenLiteral() string { return is.Token.Literal }
 
+// ElseIfClause represents one 'else if condition: consequence' branch of an IfStatement
+type ElseIfClause struct {
+	Token       token.Token // The 'if' token following 'else'
+	Condition   Expression
+	Consequence *BlockStatement
+}
+
+func (ec *ElseIfClause) TokenLiteral() string { return ec.Token.Literal }
+
 // WhileLoop represents: feast while condition: body beef
 type WhileLoop struct {
 	Token     token.Token // The 'feast' or 'while' token
@@ -138,27 +178,80 @@ type WhileLoop struct {
 func (wl *WhileLoop) statementNode()       {}
 func (wl *WhileLoop) TokenLiteral() string { return wl.Token.Literal }
 
+// ForLoop represents: feast for x in iterable: body beef
+type ForLoop struct {
+	Token    token.Token // The 'feast' or 'for' token
+	Variable *Identifier // Bound to each element in turn
+	Iterable Expression  // A range, array, string or hash
+	Body     *BlockStatement
+}
+
+func (fl *ForLoop) statementNode()       {}
+func (fl *ForLoop) TokenLiteral() string { return fl.Token.Literal }
+
+// BreakStatement represents: sacrifice (exit the innermost loop)
+type BreakStatement struct {
+	Token token.Token // The 'sacrifice' token
+}
+
+func (bs *BreakStatement) statementNode()       {}
+func (bs *BreakStatement) TokenLiteral() string { return bs.Token.Literal }
+
+// ContinueStatement represents: repent (skip to the next iteration of the innermost loop)
+type ContinueStatement struct {
+	Token token.Token // The 'repent' token
+}
+
+func (cs *ContinueStatement) statementNode()       {}
+func (cs *ContinueStatement) TokenLiteral() string { return cs.Token.Literal }
+
 // FunctionDeclaration represents: praise name(params): body beef
+// Defaults holds one entry per parameter: its default value expression
+// (praise greet(name, greeting = "Braised be")), or nil when it has none.
+// Rest is the optional final ...parts parameter that collects extra arguments.
 type FunctionDeclaration struct {
 	Token      token.Token
 	Name       *Identifier
 	Parameters []*Identifier
+	Defaults   []Expression
+	Rest       *Identifier
 	Body       *BlockStatement
 }
 
 func (fd *FunctionDeclaration) statementNode()       {}
 func (fd *FunctionDeclaration) TokenLiteral() string { return fd.Token.Literal }
 
+// FunctionLiteral represents an anonymous function expression: praise(x): serve x * 2 beef
+type FunctionLiteral struct {
+	Token      token.Token // The 'praise' token
+	Parameters []*Identifier
+	Defaults   []Expression // Same layout as FunctionDeclaration.Defaults
+	Rest       *Identifier
+	Body       *BlockStatement
+}
+
+func (fl *FunctionLiteral) expressionNode()      {}
+func (fl *FunctionLiteral) TokenLiteral() string { return fl.Token.Literal }
+
 // FunctionCall represents: preach(42)
+// Keyword arguments (connect(host = "x", port = 1)) follow the positional ones.
 type FunctionCall struct {
 	Token     token.Token
 	Function  Expression
 	Arguments []Expression
+	Keywords  []*KeywordArgument
 }
 
 func (fc *FunctionCall) expressionNode()      {}
 func (fc *FunctionCall) TokenLiteral() string { return fc.Token.Literal }
 
+// KeywordArgument represents a named argument in a call: port = 1
+type KeywordArgument struct {
+	Token token.Token // The parameter name token
+	Name  *Identifier
+	Value Expression
+}
+
 // BlockStatement represents a block of statements
 type BlockStatement struct {
 	Token      token.Token
@@ -186,6 +279,18 @@ type WrangleStatement struct {
 func (ws *WrangleStatement) statementNode()       {}
 func (ws *WrangleStatement) TokenLiteral() string { return ws.Token.Literal }
 
+// HerdStatement represents: herd utils(add, PI)
+// It names the module a file defines and, optionally, the names it exports.
+// Without an export list every top-level declaration is exported.
+type HerdStatement struct {
+	Token   token.Token // The 'herd' token
+	Name    *Identifier
+	Exports []*Identifier
+}
+
+func (hs *HerdStatement) statementNode()       {}
+func (hs *HerdStatement) TokenLiteral() string { return hs.Token.Literal }
+
 // MemberAccessExpression represents: object.member (like io.preach)
 type MemberAccessExpression struct {
 	Token  token.Token // The '.' token
@@ -195,3 +300,97 @@ type MemberAccessExpression struct {
 
 func (ma *MemberAccessExpression) expressionNode()      {}
 func (ma *MemberAccessExpression) TokenLiteral() string { return ma.Token.Literal }
+
+// ArrayLiteral represents an array literal: [1, 2, 3]
+type ArrayLiteral struct {
+	Token    token.Token // The '[' token
+	Elements []Expression
+}
+
+func (al *ArrayLiteral) expressionNode()      {}
+func (al *ArrayLiteral) TokenLiteral() string { return al.Token.Literal }
+
+// HashLiteral represents a hash map literal: {"name": "Beef", 1: true}
+// Pairs keep source order so keys and values are evaluated left to right.
+type HashLiteral struct {
+	Token token.Token // The '{' token
+	Pairs []*HashLiteralPair
+}
+
+func (hl *HashLiteral) expressionNode()      {}
+func (hl *HashLiteral) TokenLiteral() string { return hl.Token.Literal }
+
+// HashLiteralPair is a single key: value entry in a HashLiteral
+type HashLiteralPair struct {
+	Token token.Token // The first token of the key, where key errors are reported
+	Key   Expression
+	Value Expression
+}
+
+// IndexExpression represents array/string/hash indexing: arr[0], h["key"]
+type IndexExpression struct {
+	Token token.Token // The '[' token
+	Left  Expression  // The array/string being indexed
+	Index Expression  // The index expression (could be any expression)
+}
+
+func (ie *IndexExpression) expressionNode()      {}
+func (ie *IndexExpression) TokenLiteral() string { return ie.Token.Literal }
+
+// IndexAssignmentStatement represents: arr[0] = 99 or h["key"] = 99
+type IndexAssignmentStatement struct {
+	Token  token.Token // The '=' token
+	Target *IndexExpression
+	Value  Expression
+}
+
+func (ia *IndexAssignmentStatement) statementNode()       {}
+func (ia *IndexAssignmentStatement) TokenLiteral() string { return ia.Token.Literal }
+
+// TryStatement represents: try: ... catch err: ... beef
+// An error raised in Body is bound to ErrorName while Handler runs.
+type TryStatement struct {
+	Token     token.Token // The 'try' token
+	Body      *BlockStatement
+	ErrorName *Identifier
+	Handler   *BlockStatement
+}
+
+func (ts *TryStatement) statementNode()       {}
+func (ts *TryStatement) TokenLiteral() string { return ts.Token.Literal }
+
+// SmiteStatement represents: smite err (raise an error)
+type SmiteStatement struct {
+	Token token.Token // The 'smite' token
+	Value Expression
+}
+
+func (ss *SmiteStatement) statementNode()       {}
+func (ss *SmiteStatement) TokenLiteral() string { return ss.Token.Literal }
+
+// StructDeclaration represents a record type:
+//
+//	congregation Steak(cut, weight):
+//	   praise describe(self): serve self.cut beef
+//	beef
+//
+// The method list (and its colon/beef) may be omitted.
+type StructDeclaration struct {
+	Token   token.Token // The 'congregation' token
+	Name    *Identifier
+	Fields  []*Identifier
+	Methods []*FunctionDeclaration
+}
+
+func (sd *StructDeclaration) statementNode()       {}
+func (sd *StructDeclaration) TokenLiteral() string { return sd.Token.Literal }
+
+// MemberAssignmentStatement represents: steak.weight = 12
+type MemberAssignmentStatement struct {
+	Token  token.Token // The '=' token
+	Target *MemberAccessExpression
+	Value  Expression
+}
+
+func (ms *MemberAssignmentStatement) statementNode()       {}
+func (ms *MemberAssignmentStatement) TokenLiteral() string { return ms.Token.Literal }
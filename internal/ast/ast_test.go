@@ -21,6 +21,20 @@ func TestIntegerLiteralNode(t *testing.T) {
 	var _ Expression = intLiteral
 }
 
+func TestFloatLiteralNode(t *testing.T) {
+	tok := token.Token{Type: token.FLOAT, Literal: "3.14", Line: 1, Column: 1}
+	floatLiteral := &FloatLiteral{
+		Token: tok,
+		Value: 3.14,
+	}
+
+	assert.Equal(t, "3.14", floatLiteral.TokenLiteral())
+	assert.Equal(t, 3.14, floatLiteral.Value)
+
+	// Verify it implements Expression interface
+	var _ Expression = floatLiteral
+}
+
 func TestBooleanLiteralNode(t *testing.T) {
 	trueTok := token.Token{Type: token.TRUE, Literal: "true", Line: 1, Column: 1}
 	trueLiteral := &BooleanLiteral{
@@ -135,6 +149,29 @@ func TestVariableDeclarationNode(t *testing.T) {
 	var _ Statement = varDecl
 }
 
+func TestConstantDeclarationNode(t *testing.T) {
+	// pack x = 42
+	tok := token.Token{Type: token.PACK, Literal: "pack", Line: 1, Column: 1}
+	constDecl := &ConstantDeclaration{
+		Token: tok,
+		Name: &Identifier{
+			Token: token.Token{Type: token.IDENT, Literal: "x"},
+			Value: "x",
+		},
+		Value: &IntegerLiteral{
+			Token: token.Token{Type: token.INT, Literal: "42"},
+			Value: 42,
+		},
+	}
+
+	assert.Equal(t, "pack", constDecl.TokenLiteral())
+	assert.Equal(t, "x", constDecl.Name.Value)
+	assert.NotNil(t, constDecl.Value)
+
+	// Verify it implements Statement interface
+	var _ Statement = constDecl
+}
+
 func TestReturnStatementNode(t *testing.T) {
 	// serve 42
 	tok := token.Token{Type: token.SERVE, Literal: "serve", Line: 1, Column: 1}
@@ -178,6 +215,27 @@ func TestIfStatementNode(t *testing.T) {
 	var _ Statement = ifStmt
 }
 
+func TestIfStatementWithElseIfNode(t *testing.T) {
+	// if a: ... else if b: ... beef
+	ifStmt := &IfStatement{
+		Token:       token.Token{Type: token.IF, Literal: "if", Line: 1, Column: 1},
+		Condition:   &Identifier{Token: token.Token{Type: token.IDENT, Literal: "a"}, Value: "a"},
+		Consequence: &BlockStatement{Statements: []Statement{}},
+		ElseIfs: []*ElseIfClause{
+			{
+				Token:       token.Token{Type: token.IF, Literal: "if", Line: 3, Column: 6},
+				Condition:   &Identifier{Token: token.Token{Type: token.IDENT, Literal: "b"}, Value: "b"},
+				Consequence: &BlockStatement{Statements: []Statement{}},
+			},
+		},
+	}
+
+	assert.Len(t, ifStmt.ElseIfs, 1)
+	assert.Equal(t, "if", ifStmt.ElseIfs[0].TokenLiteral())
+	assert.NotNil(t, ifStmt.ElseIfs[0].Condition)
+	assert.Nil(t, ifStmt.Alternative)
+}
+
 func TestFunctionDeclarationNode(t *testing.T) {
 	// praise add(x, y): ... beef
 	tok := token.Token{Type: token.PRAISE, Literal: "praise", Line: 1, Column: 1}
@@ -205,6 +263,27 @@ func TestFunctionDeclarationNode(t *testing.T) {
 	var _ Statement = fnDecl
 }
 
+func TestFunctionLiteralNode(t *testing.T) {
+	// praise(x): serve x * 2 beef
+	tok := token.Token{Type: token.PRAISE, Literal: "praise", Line: 1, Column: 1}
+	fnLit := &FunctionLiteral{
+		Token: tok,
+		Parameters: []*Identifier{
+			{Token: token.Token{Type: token.IDENT, Literal: "x"}, Value: "x"},
+		},
+		Body: &BlockStatement{
+			Statements: []Statement{},
+		},
+	}
+
+	assert.Equal(t, "praise", fnLit.TokenLiteral())
+	assert.Len(t, fnLit.Parameters, 1)
+	assert.NotNil(t, fnLit.Body)
+
+	// Verify it implements Expression interface
+	var _ Expression = fnLit
+}
+
 func TestFunctionCallNode(t *testing.T) {
 	// preach(42)
 	tok := token.Token{Type: token.IDENT, Literal: "preach", Line: 1, Column: 1}
@@ -256,3 +335,212 @@ func TestBlockStatementNode(t *testing.T) {
 	// Verify it implements Statement interface
 	var _ Statement = block
 }
+
+func TestArrayLiteralNode(t *testing.T) {
+	// [1, 2]
+	array := &ArrayLiteral{
+		Token: token.Token{Type: token.LBRACKET, Literal: "[", Line: 1, Column: 1},
+		Elements: []Expression{
+			&IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "1"}, Value: 1},
+			&IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "2"}, Value: 2},
+		},
+	}
+
+	assert.Equal(t, "[", array.TokenLiteral())
+	assert.Len(t, array.Elements, 2)
+
+	// Verify it implements Expression interface
+	var _ Expression = array
+}
+
+func TestIndexExpressionNode(t *testing.T) {
+	// arr[0]
+	index := &IndexExpression{
+		Token: token.Token{Type: token.LBRACKET, Literal: "[", Line: 1, Column: 4},
+		Left:  &Identifier{Token: token.Token{Type: token.IDENT, Literal: "arr"}, Value: "arr"},
+		Index: &IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "0"}, Value: 0},
+	}
+
+	assert.Equal(t, "[", index.TokenLiteral())
+	assert.NotNil(t, index.Left)
+	assert.NotNil(t, index.Index)
+
+	// Verify it implements Expression interface
+	var _ Expression = index
+}
+
+func TestIndexAssignmentStatementNode(t *testing.T) {
+	// arr[0] = 99
+	assign := &IndexAssignmentStatement{
+		Token: token.Token{Type: token.ASSIGN, Literal: "=", Line: 1, Column: 8},
+		Target: &IndexExpression{
+			Token: token.Token{Type: token.LBRACKET, Literal: "["},
+			Left:  &Identifier{Token: token.Token{Type: token.IDENT, Literal: "arr"}, Value: "arr"},
+			Index: &IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "0"}, Value: 0},
+		},
+		Value: &IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "99"}, Value: 99},
+	}
+
+	assert.Equal(t, "=", assign.TokenLiteral())
+	assert.NotNil(t, assign.Target)
+	assert.NotNil(t, assign.Value)
+
+	// Verify it implements Statement interface
+	var _ Statement = assign
+}
+
+func TestHashLiteralNode(t *testing.T) {
+	// {"a": 1}
+	hash := &HashLiteral{
+		Token: token.Token{Type: token.LBRACE, Literal: "{", Line: 1, Column: 1},
+		Pairs: []*HashLiteralPair{
+			{
+				Key:   &StringLiteral{Token: token.Token{Type: token.STRING, Literal: "a"}, Value: "a"},
+				Value: &IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "1"}, Value: 1},
+			},
+		},
+	}
+
+	assert.Equal(t, "{", hash.TokenLiteral())
+	assert.Len(t, hash.Pairs, 1)
+
+	// Verify it implements Expression interface
+	var _ Expression = hash
+}
+
+func TestHerdStatementNode(t *testing.T) {
+	// herd utils(add)
+	tok := token.Token{Type: token.HERD, Literal: "herd", Line: 1, Column: 1}
+	herd := &HerdStatement{
+		Token: tok,
+		Name:  &Identifier{Token: token.Token{Type: token.IDENT, Literal: "utils"}, Value: "utils"},
+		Exports: []*Identifier{
+			{Token: token.Token{Type: token.IDENT, Literal: "add"}, Value: "add"},
+		},
+	}
+
+	assert.Equal(t, "herd", herd.TokenLiteral())
+	assert.Equal(t, "utils", herd.Name.Value)
+	assert.Len(t, herd.Exports, 1)
+
+	// Verify it implements Statement interface
+	var _ Statement = herd
+}
+
+func TestLoopControlNodes(t *testing.T) {
+	breakStmt := &BreakStatement{Token: token.Token{Type: token.SACRIFICE, Literal: "sacrifice", Line: 1, Column: 1}}
+	continueStmt := &ContinueStatement{Token: token.Token{Type: token.REPENT, Literal: "repent", Line: 2, Column: 1}}
+
+	assert.Equal(t, "sacrifice", breakStmt.TokenLiteral())
+	assert.Equal(t, "repent", continueStmt.TokenLiteral())
+
+	// Verify they implement Statement interface
+	var _ Statement = breakStmt
+	var _ Statement = continueStmt
+}
+
+func TestForLoopNode(t *testing.T) {
+	// feast for x in items: ... beef
+	loop := &ForLoop{
+		Token:    token.Token{Type: token.FEAST_WHILE, Literal: "feast", Line: 1, Column: 1},
+		Variable: &Identifier{Token: token.Token{Type: token.IDENT, Literal: "x"}, Value: "x"},
+		Iterable: &Identifier{Token: token.Token{Type: token.IDENT, Literal: "items"}, Value: "items"},
+		Body:     &BlockStatement{Statements: []Statement{}},
+	}
+
+	assert.Equal(t, "feast", loop.TokenLiteral())
+	assert.Equal(t, "x", loop.Variable.Value)
+	assert.NotNil(t, loop.Iterable)
+
+	// Verify it implements Statement interface
+	var _ Statement = loop
+}
+
+func TestTryStatementNode(t *testing.T) {
+	// try: ... catch err: ... beef
+	tok := token.Token{Type: token.TRY, Literal: "try", Line: 1, Column: 1}
+	tryStmt := &TryStatement{
+		Token:     tok,
+		Body:      &BlockStatement{Statements: []Statement{}},
+		ErrorName: &Identifier{Token: token.Token{Type: token.IDENT, Literal: "err"}, Value: "err"},
+		Handler:   &BlockStatement{Statements: []Statement{}},
+	}
+
+	assert.Equal(t, "try", tryStmt.TokenLiteral())
+	assert.Equal(t, "err", tryStmt.ErrorName.Value)
+
+	smite := &SmiteStatement{
+		Token: token.Token{Type: token.SMITE, Literal: "smite"},
+		Value: tryStmt.ErrorName,
+	}
+	assert.Equal(t, "smite", smite.TokenLiteral())
+
+	// Verify they implement Statement interface
+	var _ Statement = tryStmt
+	var _ Statement = smite
+}
+
+func TestStructDeclarationNode(t *testing.T) {
+	// congregation Steak(cut): praise describe(self): ... beef beef
+	tok := token.Token{Type: token.CONGREGATION, Literal: "congregation", Line: 1, Column: 1}
+	decl := &StructDeclaration{
+		Token: tok,
+		Name:  &Identifier{Token: token.Token{Type: token.IDENT, Literal: "Steak"}, Value: "Steak"},
+		Fields: []*Identifier{
+			{Token: token.Token{Type: token.IDENT, Literal: "cut"}, Value: "cut"},
+		},
+		Methods: []*FunctionDeclaration{
+			{
+				Token:      token.Token{Type: token.PRAISE, Literal: "praise"},
+				Name:       &Identifier{Token: token.Token{Type: token.IDENT, Literal: "describe"}, Value: "describe"},
+				Parameters: []*Identifier{{Token: token.Token{Type: token.IDENT, Literal: "self"}, Value: "self"}},
+				Body:       &BlockStatement{Statements: []Statement{}},
+			},
+		},
+	}
+
+	assert.Equal(t, "congregation", decl.TokenLiteral())
+	assert.Equal(t, "Steak", decl.Name.Value)
+	assert.Len(t, decl.Fields, 1)
+	assert.Len(t, decl.Methods, 1)
+
+	// Verify it implements Statement interface
+	var _ Statement = decl
+}
+
+func TestMemberAssignmentStatementNode(t *testing.T) {
+	// steak.weight = 12
+	assign := &MemberAssignmentStatement{
+		Token: token.Token{Type: token.ASSIGN, Literal: "="},
+		Target: &MemberAccessExpression{
+			Token:  token.Token{Type: token.DOT, Literal: "."},
+			Object: &Identifier{Token: token.Token{Type: token.IDENT, Literal: "steak"}, Value: "steak"},
+			Member: &Identifier{Token: token.Token{Type: token.IDENT, Literal: "weight"}, Value: "weight"},
+		},
+		Value: &IntegerLiteral{Token: token.Token{Type: token.INT, Literal: "12"}, Value: 12},
+	}
+
+	assert.Equal(t, "=", assign.TokenLiteral())
+	assert.Equal(t, "weight", assign.Target.Member.Value)
+
+	// Verify it implements Statement interface
+	var _ Statement = assign
+}
+
+func TestInterpolatedStringNode(t *testing.T) {
+	// "total: {sum}"
+	tok := token.Token{Type: token.INTERPOLATED, Literal: "total: {sum}", Line: 1, Column: 1}
+	str := &InterpolatedString{
+		Token: tok,
+		Parts: []Expression{
+			&StringLiteral{Token: token.Token{Type: token.STRING, Literal: "total: "}, Value: "total: "},
+			&Identifier{Token: token.Token{Type: token.IDENT, Literal: "sum"}, Value: "sum"},
+		},
+	}
+
+	assert.Equal(t, "total: {sum}", str.TokenLiteral())
+	assert.Len(t, str.Parts, 2)
+
+	// Verify it implements Expression interface
+	var _ Expression = str
+}
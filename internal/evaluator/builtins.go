@@ -0,0 +1,127 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/elitwilson/beeflang/internal/object"
+)
+
+// builtins are global functions available without a wrangle statement.
+// They are looked up after the environment, so user code can shadow them.
+var builtins = map[string]*object.Builtin{
+	// len - number of elements in an array or range, characters in a string, or entries in a hash
+	"len": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return builtinError("wrong number of arguments to len: expected 1, got %d", len(args))
+			}
+
+			switch arg := args[0].(type) {
+			case *object.Array:
+				return &object.Integer{Value: int64(len(arg.Elements))}
+			case *object.String:
+				return &object.Integer{Value: arg.Len()}
+			case *object.Hash:
+				return &object.Integer{Value: int64(len(arg.Pairs))}
+			case *object.Range:
+				return &object.Integer{Value: arg.Len()}
+			default:
+				return builtinError("argument to len not supported: %s", args[0].Type())
+			}
+		},
+	},
+
+	// push - returns a new array with the value appended (the original is unchanged)
+	"push": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 2 {
+				return builtinError("wrong number of arguments to push: expected 2, got %d", len(args))
+			}
+
+			array, ok := args[0].(*object.Array)
+			if !ok {
+				return builtinError("first argument to push must be ARRAY, got %s", args[0].Type())
+			}
+
+			elements := make([]object.Object, len(array.Elements), len(array.Elements)+1)
+			copy(elements, array.Elements)
+			return &object.Array{Elements: append(elements, args[1])}
+		},
+	},
+
+	// range - integers for counted loops: range(end), range(start, end) or range(start, end, step)
+	// The end is exclusive, so range(0, 3) produces 0, 1, 2
+	"range": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) < 1 || len(args) > 3 {
+				return builtinError("wrong number of arguments to range: expected 1 to 3, got %d", len(args))
+			}
+
+			bounds := make([]int64, len(args))
+			for i, arg := range args {
+				integer, ok := arg.(*object.Integer)
+				if !ok {
+					return builtinError("arguments to range must be INTEGER, got %s", arg.Type())
+				}
+				bounds[i] = integer.Value
+			}
+
+			r := &object.Range{Start: 0, Step: 1}
+			switch len(bounds) {
+			case 1:
+				r.End = bounds[0]
+			case 2:
+				r.Start, r.End = bounds[0], bounds[1]
+			case 3:
+				r.Start, r.End, r.Step = bounds[0], bounds[1], bounds[2]
+			}
+
+			if r.Step == 0 {
+				return builtinError("range step cannot be zero")
+			}
+			return r
+		},
+	},
+
+	// int - converts a FLOAT (truncating toward zero) or INTEGER to an INTEGER
+	"int": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return builtinError("wrong number of arguments to int: expected 1, got %d", len(args))
+			}
+
+			switch arg := args[0].(type) {
+			case *object.Integer:
+				return arg
+			case *object.Float:
+				return &object.Integer{Value: int64(arg.Value)}
+			default:
+				return builtinError("argument to int not supported: %s", args[0].Type())
+			}
+		},
+	},
+
+	// float - converts an INTEGER or FLOAT to a FLOAT
+	"float": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return builtinError("wrong number of arguments to float: expected 1, got %d", len(args))
+			}
+
+			switch arg := args[0].(type) {
+			case *object.Integer:
+				return &object.Float{Value: float64(arg.Value)}
+			case *object.Float:
+				return arg
+			default:
+				return builtinError("argument to float not supported: %s", args[0].Type())
+			}
+		},
+	},
+}
+
+// builtinError creates an Error without location information.
+// evalFunctionCall fills in the call site's line and column.
+func builtinError(format string, a ...interface{}) *object.Error {
+	return &object.Error{Message: fmt.Sprintf(format, a...)}
+}
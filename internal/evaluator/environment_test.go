@@ -83,3 +83,63 @@ func TestEnvironmentShadowing(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, int64(10), x.(*object.Integer).Value, "Outer scope should still have original value")
 }
+
+func TestEnvironmentConstants(t *testing.T) {
+	outer := NewEnvironment()
+	outer.SetConstant("limit", &object.Integer{Value: 10})
+	outer.Set("count", &object.Integer{Value: 0})
+
+	inner := NewEnclosedEnvironment(outer)
+
+	// Constness is resolved through the scope that declares the name
+	assert.True(t, inner.IsConstant("limit"))
+	assert.False(t, inner.IsConstant("count"))
+	assert.False(t, inner.IsConstant("missing"))
+
+	// Only the declaring scope reports the constant as its own
+	assert.True(t, outer.DeclaresConstant("limit"))
+	assert.False(t, inner.DeclaresConstant("limit"))
+
+	// A variable in an inner scope shadows the outer constant
+	inner.Set("limit", &object.Integer{Value: 99})
+	assert.False(t, inner.IsConstant("limit"))
+	assert.True(t, outer.IsConstant("limit"))
+}
+
+func TestEnvironmentAssign(t *testing.T) {
+	outer := NewEnvironment()
+	outer.Set("x", &object.Integer{Value: 10})
+
+	inner := NewEnclosedEnvironment(outer)
+
+	// Assign updates the variable in the scope that declared it
+	ok := inner.Assign("x", &object.Integer{Value: 20})
+	assert.True(t, ok, "Assign should find 'x' in the outer scope")
+
+	x, _ := outer.Get("x")
+	assert.Equal(t, int64(20), x.(*object.Integer).Value, "Outer scope should see the new value")
+
+	// Assign does not create new variables
+	ok = inner.Assign("missing", &object.Integer{Value: 1})
+	assert.False(t, ok, "Assign should report unknown variables")
+	_, found := inner.Get("missing")
+	assert.False(t, found, "Assign should not create 'missing'")
+}
+
+func TestEnvironmentCall(t *testing.T) {
+	global := NewEnvironment()
+	assert.Nil(t, global.Call(), "top-level code runs outside any call")
+
+	outer := &object.Call{Function: "outer"}
+	fnEnv := object.NewCallEnvironment(global, outer)
+	block := NewEnclosedEnvironment(fnEnv)
+
+	// Blocks inside a function belong to its call
+	assert.Same(t, outer, fnEnv.Call())
+	assert.Same(t, outer, block.Call())
+
+	// A closure defined in the block gets its own call when it runs
+	inner := &object.Call{Function: "inner", Caller: outer}
+	closureEnv := object.NewCallEnvironment(block, inner)
+	assert.Same(t, inner, closureEnv.Call())
+}
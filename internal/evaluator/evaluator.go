@@ -3,7 +3,10 @@ package evaluator
 import (
 	"bufio"
 	"fmt"
+	"math"
 	"os"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/elitwilson/beeflang/internal/ast"
 	"github.com/elitwilson/beeflang/internal/object"
@@ -23,12 +26,18 @@ func Eval(node ast.Node, env *Environment) object.Object {
 	case *ast.IntegerLiteral:
 		return &object.Integer{Value: n.Value}
 
+	case *ast.FloatLiteral:
+		return &object.Float{Value: n.Value}
+
 	case *ast.BooleanLiteral:
 		return nativeBoolToBooleanObject(n.Value)
 
 	case *ast.StringLiteral:
 		return &object.String{Value: n.Value}
 
+	case *ast.InterpolatedString:
+		return evalInterpolatedString(n, env)
+
 	// Identifiers: look up variable in environment
 	case *ast.Identifier:
 		return evalIdentifier(n, env)
@@ -42,6 +51,11 @@ func Eval(node ast.Node, env *Environment) object.Object {
 		return evalPrefixExpression(n.Token, n.Operator, right)
 
 	case *ast.InfixExpression:
+		// Logical operators short-circuit, so the right side must not be evaluated eagerly
+		if isLogicalOperator(n.Operator) {
+			return evalLogicalExpression(n, env)
+		}
+
 		left := Eval(n.Left, env)
 		if isError(left) {
 			return left
@@ -54,10 +68,19 @@ func Eval(node ast.Node, env *Environment) object.Object {
 
 	// Statements
 	case *ast.VariableDeclaration:
+		if env.DeclaresConstant(n.Name.Value) {
+			return newError(n.Name.Token, "cannot redeclare constant: %s", n.Name.Value)
+		}
 		val := Eval(n.Value, env)
+		if isError(val) {
+			return val
+		}
 		env.Set(n.Name.Value, val)
 		return val
 
+	case *ast.ConstantDeclaration:
+		return evalConstantDeclaration(n, env)
+
 	case *ast.AssignmentStatement:
 		return evalAssignmentStatement(n, env)
 
@@ -70,21 +93,67 @@ func Eval(node ast.Node, env *Environment) object.Object {
 	case *ast.WhileLoop:
 		return evalWhileLoop(n, env)
 
+	case *ast.ForLoop:
+		return evalForLoop(n, env)
+
 	case *ast.FunctionDeclaration:
 		return evalFunctionDeclaration(n, env)
 
+	case *ast.FunctionLiteral:
+		return &object.Function{
+			Parameters: n.Parameters,
+			Defaults:   n.Defaults,
+			Rest:       n.Rest,
+			Body:       n.Body,
+			Env:        env, // Capture current environment (closure)
+		}
+
 	case *ast.ReturnStatement:
 		return evalReturnStatement(n, env)
 
+	case *ast.BreakStatement:
+		return object.BREAK
+
+	case *ast.ContinueStatement:
+		return object.CONTINUE
+
 	case *ast.FunctionCall:
 		return evalFunctionCall(n, env)
 
 	case *ast.WrangleStatement:
 		return evalWrangleStatement(n, env)
 
+	case *ast.TryStatement:
+		return evalTryStatement(n, env)
+
+	case *ast.SmiteStatement:
+		return evalSmiteStatement(n, env)
+
+	case *ast.StructDeclaration:
+		return evalStructDeclaration(n, env)
+
+	case *ast.MemberAssignmentStatement:
+		return evalMemberAssignmentStatement(n, env)
+
+	case *ast.HerdStatement:
+		// herd only describes the module to the loader (see loadModuleFile)
+		return object.NULL
+
 	case *ast.MemberAccessExpression:
 		return evalMemberAccessExpression(n, env)
 
+	case *ast.ArrayLiteral:
+		return evalArrayLiteral(n, env)
+
+	case *ast.HashLiteral:
+		return evalHashLiteral(n, env)
+
+	case *ast.IndexExpression:
+		return evalIndexExpression(n, env)
+
+	case *ast.IndexAssignmentStatement:
+		return evalIndexAssignmentStatement(n, env)
+
 	// Expression statement: evaluate the expression
 	case *ast.ExpressionStatement:
 		return Eval(n.Expression, env)
@@ -116,17 +185,22 @@ func evalProgram(program *ast.Program, env *Environment) object.Object {
 
 // evalIdentifier looks up a variable in the environment
 func evalIdentifier(node *ast.Identifier, env *Environment) object.Object {
-	val, ok := env.Get(node.Value)
-	if !ok {
-		return newError(node.Token, "identifier not found: %s", node.Value)
+	if val, ok := env.Get(node.Value); ok {
+		return val
 	}
-	return val
+
+	// Fall back to global builtins like len() - user bindings can shadow them
+	if builtin, ok := builtins[node.Value]; ok {
+		return builtin
+	}
+
+	return newError(node.Token, "identifier not found: %s", node.Value)
 }
 
 // evalPrefixExpression evaluates prefix expressions like -5 or !true
 func evalPrefixExpression(tok token.Token, operator string, right object.Object) object.Object {
 	switch operator {
-	case "!":
+	case "!", "not":
 		return evalBangOperator(right)
 	case "-":
 		return evalMinusPrefixOperator(tok, right)
@@ -151,12 +225,14 @@ func evalBangOperator(right object.Object) object.Object {
 
 // evalMinusPrefixOperator implements the - (negation) operator
 func evalMinusPrefixOperator(tok token.Token, right object.Object) object.Object {
-	if right.Type() != "INTEGER" {
+	switch right := right.(type) {
+	case *object.Integer:
+		return &object.Integer{Value: -right.Value}
+	case *object.Float:
+		return &object.Float{Value: -right.Value}
+	default:
 		return newError(tok, "unknown operator: -%s", right.Type())
 	}
-
-	value := right.(*object.Integer).Value
-	return &object.Integer{Value: -value}
 }
 
 // evalInfixExpression evaluates infix expressions like 5 + 3 or 10 > 5
@@ -166,6 +242,10 @@ func evalInfixExpression(tok token.Token, operator string, left, right object.Ob
 	case left.Type() == "INTEGER" && right.Type() == "INTEGER":
 		return evalIntegerInfixExpression(tok, operator, left, right)
 
+	// Float and mixed numeric operations (INTEGER operands are promoted to FLOAT)
+	case isNumeric(left) && isNumeric(right):
+		return evalFloatInfixExpression(tok, operator, left, right)
+
 	// String concatenation
 	case left.Type() == "STRING" && right.Type() == "STRING":
 		return evalStringInfixExpression(tok, operator, left, right)
@@ -185,6 +265,41 @@ func evalInfixExpression(tok token.Token, operator string, left, right object.Ob
 	}
 }
 
+// isLogicalOperator reports whether operator is and/or in either spelling
+func isLogicalOperator(operator string) bool {
+	switch operator {
+	case "and", "&&", "or", "||":
+		return true
+	default:
+		return false
+	}
+}
+
+// evalLogicalExpression evaluates and/or with short-circuit semantics:
+// the right side is only evaluated when the left side doesn't decide the result.
+// Operands can be any value (using truthiness); the result is always a Boolean.
+func evalLogicalExpression(node *ast.InfixExpression, env *Environment) object.Object {
+	left := Eval(node.Left, env)
+	if isError(left) {
+		return left
+	}
+
+	isAnd := node.Operator == "and" || node.Operator == "&&"
+	if isAnd && !isTruthy(left) {
+		return object.FALSE
+	}
+	if !isAnd && isTruthy(left) {
+		return object.TRUE
+	}
+
+	right := Eval(node.Right, env)
+	if isError(right) {
+		return right
+	}
+
+	return nativeBoolToBooleanObject(isTruthy(right))
+}
+
 // evalIntegerInfixExpression handles arithmetic and comparison on integers
 func evalIntegerInfixExpression(tok token.Token, operator string, left, right object.Object) object.Object {
 	leftVal := left.(*object.Integer).Value
@@ -198,9 +313,13 @@ func evalIntegerInfixExpression(tok token.Token, operator string, left, right ob
 		return &object.Integer{Value: leftVal - rightVal}
 	case "*":
 		return &object.Integer{Value: leftVal * rightVal}
-	case "/":
-		return &object.Integer{Value: leftVal / rightVal}
-	case "%":
+	case "/", "%":
+		if rightVal == 0 {
+			return newError(tok, "division by zero")
+		}
+		if operator == "/" {
+			return &object.Integer{Value: leftVal / rightVal}
+		}
 		return &object.Integer{Value: leftVal % rightVal}
 
 	// Comparison
@@ -222,6 +341,63 @@ func evalIntegerInfixExpression(tok token.Token, operator string, left, right ob
 	}
 }
 
+// evalFloatInfixExpression handles arithmetic and comparison when at least one
+// operand is a FLOAT. Integers are promoted, so 7 / 2.0 is 3.5 and 1 == 1.0 is true.
+// Two INTEGER operands never reach here - integer division stays integer division.
+func evalFloatInfixExpression(tok token.Token, operator string, left, right object.Object) object.Object {
+	leftVal := toFloat(left)
+	rightVal := toFloat(right)
+
+	switch operator {
+	// Arithmetic
+	case "+":
+		return &object.Float{Value: leftVal + rightVal}
+	case "-":
+		return &object.Float{Value: leftVal - rightVal}
+	case "*":
+		return &object.Float{Value: leftVal * rightVal}
+	case "/", "%":
+		// Reported like integer division rather than producing +Inf or NaN
+		if rightVal == 0 {
+			return newError(tok, "division by zero")
+		}
+		if operator == "/" {
+			return &object.Float{Value: leftVal / rightVal}
+		}
+		return &object.Float{Value: math.Mod(leftVal, rightVal)}
+
+	// Comparison
+	case "<":
+		return nativeBoolToBooleanObject(leftVal < rightVal)
+	case ">":
+		return nativeBoolToBooleanObject(leftVal > rightVal)
+	case "==":
+		return nativeBoolToBooleanObject(leftVal == rightVal)
+	case "!=":
+		return nativeBoolToBooleanObject(leftVal != rightVal)
+	case "<=":
+		return nativeBoolToBooleanObject(leftVal <= rightVal)
+	case ">=":
+		return nativeBoolToBooleanObject(leftVal >= rightVal)
+
+	default:
+		return newError(tok, "unknown operator: %s %s %s", left.Type(), operator, right.Type())
+	}
+}
+
+// isNumeric reports whether obj is an INTEGER or FLOAT
+func isNumeric(obj object.Object) bool {
+	return obj.Type() == "INTEGER" || obj.Type() == "FLOAT"
+}
+
+// toFloat converts a numeric object to a Go float64 (callers check isNumeric first)
+func toFloat(obj object.Object) float64 {
+	if integer, ok := obj.(*object.Integer); ok {
+		return float64(integer.Value)
+	}
+	return obj.(*object.Float).Value
+}
+
 // evalStringInfixExpression handles string operations
 func evalStringInfixExpression(tok token.Token, operator string, left, right object.Object) object.Object {
 	leftVal := left.(*object.String).Value
@@ -239,6 +415,20 @@ func evalStringInfixExpression(tok token.Token, operator string, left, right obj
 	}
 }
 
+// evalInterpolatedString joins the parts of "total: {sum + 1}", converting each
+// embedded value the same way preach prints it (Inspect)
+func evalInterpolatedString(str *ast.InterpolatedString, env *Environment) object.Object {
+	var out strings.Builder
+	for _, part := range str.Parts {
+		val := Eval(part, env)
+		if isError(val) {
+			return val
+		}
+		out.WriteString(val.Inspect())
+	}
+	return &object.String{Value: out.String()}
+}
+
 // nativeBoolToBooleanObject converts a Go bool to a Boolean object
 // Uses singleton TRUE/FALSE for efficiency
 func nativeBoolToBooleanObject(input bool) *object.Boolean {
@@ -261,8 +451,8 @@ func evalBlockStatement(block *ast.BlockStatement, env *Environment) object.Obje
 			return result
 		}
 
-		// If we hit a return statement, stop executing and bubble it up
-		if result != nil && result.Type() == "RETURN_VALUE" {
+		// If we hit a return, break or continue, stop executing and bubble it up
+		if isControlSignal(result) {
 			return result
 		}
 	}
@@ -270,17 +460,35 @@ func evalBlockStatement(block *ast.BlockStatement, env *Environment) object.Obje
 	return result
 }
 
-// evalIfStatement evaluates an if/else statement
+// evalIfStatement evaluates an if/else-if/else statement
+// Conditions are checked in order and only the first truthy branch runs
+// Each branch runs in its own enclosed scope, so a prep inside the branch
+// does not leak into the surrounding code
 func evalIfStatement(ifStmt *ast.IfStatement, env *Environment) object.Object {
 	condition := Eval(ifStmt.Condition, env)
+	if isError(condition) {
+		return condition
+	}
 
 	if isTruthy(condition) {
-		return Eval(ifStmt.Consequence, env)
-	} else if ifStmt.Alternative != nil {
-		return Eval(ifStmt.Alternative, env)
-	} else {
-		return object.NULL
+		return Eval(ifStmt.Consequence, NewEnclosedEnvironment(env))
+	}
+
+	for _, clause := range ifStmt.ElseIfs {
+		condition := Eval(clause.Condition, env)
+		if isError(condition) {
+			return condition
+		}
+		if isTruthy(condition) {
+			return Eval(clause.Consequence, NewEnclosedEnvironment(env))
+		}
 	}
+
+	if ifStmt.Alternative != nil {
+		return Eval(ifStmt.Alternative, NewEnclosedEnvironment(env))
+	}
+
+	return object.NULL
 }
 
 // isTruthy determines if an object is "truthy" for conditionals
@@ -300,8 +508,15 @@ func isTruthy(obj object.Object) bool {
 
 // evalFunctionDeclaration creates a Function object and stores it in the environment
 func evalFunctionDeclaration(fn *ast.FunctionDeclaration, env *Environment) object.Object {
+	if env.DeclaresConstant(fn.Name.Value) {
+		return newError(fn.Name.Token, "cannot redeclare constant: %s", fn.Name.Value)
+	}
+
 	function := &object.Function{
+		Name:       fn.Name.Value,
 		Parameters: fn.Parameters,
+		Defaults:   fn.Defaults,
+		Rest:       fn.Rest,
 		Body:       fn.Body,
 		Env:        env, // Capture current environment (closure)
 	}
@@ -315,6 +530,9 @@ func evalFunctionDeclaration(fn *ast.FunctionDeclaration, env *Environment) obje
 // evalReturnStatement evaluates a return statement
 func evalReturnStatement(stmt *ast.ReturnStatement, env *Environment) object.Object {
 	val := Eval(stmt.ReturnValue, env)
+	if isError(val) {
+		return val
+	}
 	// Wrap in ReturnValue to signal this is an early return
 	return &object.ReturnValue{Value: val}
 }
@@ -334,28 +552,225 @@ func evalFunctionCall(call *ast.FunctionCall, env *Environment) object.Object {
 		return args[0]
 	}
 
+	// Keyword arguments (port = 1) are evaluated after the positional ones
+	keywords := make([]object.Object, len(call.Keywords))
+	for i, kw := range call.Keywords {
+		val := Eval(kw.Value, env)
+		if isError(val) {
+			return val
+		}
+		keywords[i] = val
+	}
+
 	// Check if it's a builtin function
 	if builtin, ok := function.(*object.Builtin); ok {
-		return builtin.Fn(args...)
+		if len(call.Keywords) > 0 {
+			return newError(call.Keywords[0].Token, "builtin functions do not take keyword arguments")
+		}
+		result := builtin.Fn(args...)
+		// Builtins have no access to tokens, so locate their errors (and the
+		// error values errors.new creates) at the call site
+		switch obj := result.(type) {
+		case *object.Error:
+			locateError(obj, call.Token)
+		case *object.ErrorValue:
+			locateError(obj.Err, call.Token)
+		}
+		return result
 	}
 
-	// Check if it's a user-defined function
-	fn, ok := function.(*object.Function)
-	if !ok {
-		// Not a function - error
-		return newError(call.Token, "not a function: %s", function.Type())
+	switch fn := function.(type) {
+	case *object.Function:
+		bound, err := bindArguments(call, fn, args, keywords, 0)
+		if err != nil {
+			return err
+		}
+		return callFunction(call.Token, fn, bound, env)
+	case *object.BoundMethod:
+		// The instance becomes the method's first parameter (self)
+		bound, err := bindArguments(call, fn.Method, append([]object.Object{fn.Receiver}, args...), keywords, 1)
+		if err != nil {
+			return err
+		}
+		return callFunction(call.Token, fn.Method, bound, env)
+	case *object.StructType:
+		if len(call.Keywords) > 0 {
+			return newError(call.Keywords[0].Token, "%s does not take keyword arguments", fn.Name)
+		}
+		return constructInstance(call.Token, fn, args)
+	}
+
+	// Not a function - error
+	return newError(call.Token, "not a function: %s", function.Type())
+}
+
+// bindArguments matches a call's positional and keyword arguments to fn's
+// parameters. It returns one value per parameter, nil where the default
+// applies, followed by the array for the rest parameter if fn has one.
+// implicit is the number of leading arguments the call fills in itself
+// (a method's self), which are left out of the counts in error messages.
+func bindArguments(call *ast.FunctionCall, fn *object.Function, args []object.Object, keywords []object.Object, implicit int) ([]object.Object, *object.Error) {
+	params := fn.Parameters
+	given := len(args) + len(keywords)
+	if len(args) > len(params) && fn.Rest == nil {
+		return nil, arityError(call.Token, fn, given, implicit)
+	}
+
+	bound := make([]object.Object, len(params), len(params)+1)
+	copy(bound, args)
+	if fn.Rest != nil {
+		extra := []object.Object{}
+		if len(args) > len(params) {
+			extra = append(extra, args[len(params):]...)
+		}
+		bound = append(bound, &object.Array{Elements: extra})
 	}
 
+	for i, kw := range call.Keywords {
+		idx := -1
+		for j, param := range params {
+			if param.Value == kw.Name.Value {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			names := []string{}
+			for _, param := range params[implicit:] {
+				names = append(names, param.Value)
+			}
+			return nil, newError(kw.Token, "%s has no parameter named %s (parameters: %s)",
+				functionName(fn), kw.Name.Value, strings.Join(names, ", "))
+		}
+		if bound[idx] != nil {
+			return nil, newError(kw.Token, "%s got multiple values for parameter %s", functionName(fn), kw.Name.Value)
+		}
+		bound[idx] = keywords[i]
+	}
+
+	for i, param := range params {
+		if bound[i] != nil || (i < len(fn.Defaults) && fn.Defaults[i] != nil) {
+			continue
+		}
+		if len(keywords) == 0 {
+			return nil, arityError(call.Token, fn, given, implicit)
+		}
+		return nil, newError(call.Token, "missing argument for parameter %s in call to %s", param.Value, functionName(fn))
+	}
+
+	return bound, nil
+}
+
+// arityError reports a call with too few or too many arguments, naming the
+// function and the expected and actual counts
+func arityError(tok token.Token, fn *object.Function, given int, implicit int) *object.Error {
+	required := fn.RequiredParameters() - implicit
+	total := len(fn.Parameters) - implicit
+
+	expected := fmt.Sprintf("%d", total)
+	switch {
+	case fn.Rest != nil:
+		expected = fmt.Sprintf("at least %d", required)
+	case required < total:
+		expected = fmt.Sprintf("%d to %d", required, total)
+	}
+	return newError(tok, "wrong number of arguments to %s: expected %s, got %d", functionName(fn), expected, given-implicit)
+}
+
+// functionName names a function in error messages
+func functionName(fn *object.Function) string {
+	if fn.Name == "" {
+		return "anonymous function"
+	}
+	return fn.Name
+}
+
+// maxCallDepth limits how deeply function calls can nest. Runaway recursion
+// becomes a Beeflang error well before it would overflow the Go stack, which
+// crashes the interpreter outright.
+const maxCallDepth = 10000
+
+// callFunction applies fn, recording the call so errors raised while it runs
+// can report the calls that led to them
+func callFunction(tok token.Token, fn *object.Function, args []object.Object, env *Environment) object.Object {
+	call := &object.Call{
+		Function: functionName(fn),
+		File:     fn.Env.File(),
+		Line:     tok.Line,
+		Column:   tok.Column,
+		Caller:   env.Call(),
+		Depth:    1,
+	}
+	if call.Caller != nil {
+		call.Depth = call.Caller.Depth + 1
+	}
+	if call.Depth > maxCallDepth {
+		return newError(tok, "maximum recursion depth exceeded: more than %d nested calls", maxCallDepth)
+	}
+
+	result := applyFunction(fn, args, call)
+
+	// Errors are located in the file of the code that raised them. One
+	// leaving a function declared in another file (a module function called
+	// from the program) would otherwise be read as coming from the caller's
+	// file.
+	if errObj, ok := result.(*object.Error); ok && errObj.File == "" && errObj.Line > 0 {
+		if file := fn.Env.File(); file != env.File() {
+			errObj.File = file
+		}
+	}
+	return result
+}
+
+// CallEntryPoint runs fn, the program's ChurchOfBeef, as the outermost call,
+// so it appears at the bottom of stack traces. It takes no arguments.
+func CallEntryPoint(fn *object.Function) object.Object {
+	if fn.RequiredParameters() > 0 {
+		return arityError(token.Token{}, fn, 0, 0)
+	}
+
+	args := make([]object.Object, len(fn.Parameters))
+	if fn.Rest != nil {
+		args = append(args, &object.Array{Elements: []object.Object{}})
+	}
+
+	return applyFunction(fn, args, &object.Call{Function: functionName(fn), File: fn.Env.File(), Depth: 1})
+}
+
+// applyFunction runs a user-defined function with arguments matched to its
+// parameters by bindArguments. Parameters without an argument take their
+// default, evaluated at call time in the function's scope so it can use the
+// parameters before it. call records the call in the function's scope.
+func applyFunction(fn *object.Function, args []object.Object, call *object.Call) (result object.Object) {
+	// The first call an error leaves records its stack trace, while the
+	// calls that led to it are still known
+	defer func() {
+		if errObj, ok := result.(*object.Error); ok && errObj.Stack == nil {
+			errObj.Stack = stackTrace(errObj.Line, errObj.Column, call)
+		}
+	}()
+
 	// Create new environment for function execution (enclosed by function's closure env)
-	fnEnv := object.NewEnclosedEnvironment(fn.Env)
+	fnEnv := object.NewCallEnvironment(fn.Env, call)
 
 	// Bind parameters to arguments
 	for i, param := range fn.Parameters {
-		fnEnv.Set(param.Value, args[i])
+		if args[i] != nil {
+			fnEnv.Set(param.Value, args[i])
+			continue
+		}
+		val := Eval(fn.Defaults[i], fnEnv)
+		if isError(val) {
+			return val
+		}
+		fnEnv.Set(param.Value, val)
+	}
+	if fn.Rest != nil {
+		fnEnv.Set(fn.Rest.Value, args[len(fn.Parameters)])
 	}
 
 	// Execute function body
-	result := Eval(fn.Body, fnEnv)
+	result = Eval(fn.Body, fnEnv)
 
 	// Propagate errors from function body
 	if isError(result) {
@@ -372,12 +787,32 @@ func evalFunctionCall(call *ast.FunctionCall, env *Environment) object.Object {
 	return object.NULL
 }
 
-// evalExpressions evaluates a list of expressions (used for function arguments)
+// constructInstance builds an instance of a congregation from one argument
+// per field, in declaration order: Steak("ribeye", 12)
+func constructInstance(tok token.Token, st *object.StructType, args []object.Object) object.Object {
+	if len(args) != len(st.Fields) {
+		return newError(tok, "wrong number of arguments to %s: expected %d, got %d",
+			st.Name, len(st.Fields), len(args))
+	}
+
+	instance := &object.Instance{Struct: st, Fields: make(map[string]object.Object, len(args))}
+	for i, field := range st.Fields {
+		instance.Fields[field] = args[i]
+	}
+	return instance
+}
+
+// evalExpressions evaluates expressions left to right. It stops at the first
+// error and returns a one-element slice holding just that error, which
+// callers check for.
 func evalExpressions(exps []ast.Expression, env *Environment) []object.Object {
 	result := []object.Object{}
 
 	for _, exp := range exps {
 		evaluated := Eval(exp, env)
+		if isError(evaluated) {
+			return []object.Object{evaluated}
+		}
 		result = append(result, evaluated)
 	}
 
@@ -385,9 +820,38 @@ func evalExpressions(exps []ast.Expression, env *Environment) []object.Object {
 }
 
 // evalAssignmentStatement handles variable reassignment (x = value)
+// The variable is updated in the scope that declared it, so loop bodies and
+// closures can change variables from the surrounding code. Only prep
+// introduces new names; assigning to an undeclared name is an error.
 func evalAssignmentStatement(stmt *ast.AssignmentStatement, env *Environment) object.Object {
 	val := Eval(stmt.Value, env)
-	env.Set(stmt.Name.Value, val)
+	if isError(val) {
+		return val
+	}
+
+	if env.IsConstant(stmt.Name.Value) {
+		return newError(stmt.Token, "cannot reassign constant: %s", stmt.Name.Value)
+	}
+	if !env.Assign(stmt.Name.Value, val) {
+		return newError(stmt.Token, "cannot assign to undeclared variable: %s (declare it with prep first)", stmt.Name.Value)
+	}
+	return val
+}
+
+// evalConstantDeclaration binds an immutable value: pack x = 42
+// The binding cannot be reassigned, but the value itself is not frozen, so
+// the elements of a packed array or hash can still change.
+func evalConstantDeclaration(decl *ast.ConstantDeclaration, env *Environment) object.Object {
+	if env.DeclaresConstant(decl.Name.Value) {
+		return newError(decl.Name.Token, "cannot redeclare constant: %s", decl.Name.Value)
+	}
+
+	val := Eval(decl.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	env.SetConstant(decl.Name.Value, val)
 	return val
 }
 
@@ -397,26 +861,140 @@ func evalWhileLoop(loop *ast.WhileLoop, env *Environment) object.Object {
 
 	for {
 		condition := Eval(loop.Condition, env)
+		if isError(condition) {
+			return condition
+		}
 
 		if !isTruthy(condition) {
 			break
 		}
 
-		result = Eval(loop.Body, env)
+		// Each iteration gets a fresh scope, so a prep in the body is not
+		// visible after the loop or redeclared on top of the previous pass
+		result = Eval(loop.Body, NewEnclosedEnvironment(env))
+
+		// An error or an early return ends the loop
+		if isError(result) {
+			return result
+		}
+		if result != nil && result.Type() == "RETURN_VALUE" {
+			return result
+		}
+
+		// sacrifice leaves the loop, repent skips to the next condition check
+		if result == object.BREAK {
+			return object.NULL
+		}
+		if result == object.CONTINUE {
+			result = object.NULL
+		}
+	}
+
+	return result
+}
+
+// evalForLoop handles for-each loops: feast for x in iterable: body beef
+// Each iteration gets a fresh scope holding the loop variable, so closures
+// created in the body capture that iteration's value.
+func evalForLoop(loop *ast.ForLoop, env *Environment) object.Object {
+	iterable := Eval(loop.Iterable, env)
+	if isError(iterable) {
+		return iterable
+	}
+
+	collection, ok := iterable.(object.Iterable)
+	if !ok {
+		return newError(loop.Token, "cannot iterate over %s", iterable.Type())
+	}
+
+	var result object.Object = object.NULL
+	iter := collection.Iterator()
+
+	for {
+		element, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		iterEnv := NewEnclosedEnvironment(env)
+		iterEnv.Set(loop.Variable.Value, element)
 
-		// Check for early return from within the loop
+		result = Eval(loop.Body, iterEnv)
+
+		if isError(result) {
+			return result
+		}
 		if result != nil && result.Type() == "RETURN_VALUE" {
 			return result
 		}
+
+		// sacrifice leaves the loop, repent moves on to the next element
+		if result == object.BREAK {
+			return object.NULL
+		}
+		if result == object.CONTINUE {
+			result = object.NULL
+		}
 	}
 
 	return result
 }
 
+// evalTryStatement runs the body and, if it fails, runs the handler with the
+// error bound as an ErrorValue. Returns and loop signals pass through untouched.
+func evalTryStatement(stmt *ast.TryStatement, env *Environment) object.Object {
+	result := Eval(stmt.Body, NewEnclosedEnvironment(env))
+
+	errObj, ok := result.(*object.Error)
+	if !ok {
+		return result
+	}
+
+	// Record the stack trace before the error becomes a value that could
+	// leave this function without passing through its call
+	if errObj.Stack == nil {
+		errObj.Stack = stackTrace(errObj.Line, errObj.Column, env.Call())
+	}
+
+	handlerEnv := NewEnclosedEnvironment(env)
+	handlerEnv.Set(stmt.ErrorName.Value, &object.ErrorValue{Err: errObj})
+	return Eval(stmt.Handler, handlerEnv)
+}
+
+// evalSmiteStatement raises an error. smite "message" raises a new error
+// located at the smite; smite err raises an error value (caught, or built
+// with the errors module) with the location it already has.
+func evalSmiteStatement(stmt *ast.SmiteStatement, env *Environment) object.Object {
+	val := Eval(stmt.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	switch val := val.(type) {
+	case *object.String:
+		return newError(stmt.Token, "%s", val.Value)
+	case *object.ErrorValue:
+		return val.Err
+	}
+
+	return newError(stmt.Token, "cannot smite %s: expected a STRING message or an error", val.Type())
+}
+
 func evalWrangleStatement(stmt *ast.WrangleStatement, env *Environment) object.Object {
-	// Load module by name
+	// Load module by name: a built-in module or a .beef file
 	moduleName := stmt.ModuleName.Value
-	mod := loadModule(moduleName)
+	if env.DeclaresConstant(moduleName) {
+		return newError(stmt.ModuleName.Token, "cannot redeclare constant: %s", moduleName)
+	}
+	mod := loadModule(stmt.ModuleName.Token, moduleName, env.File())
+	if errObj, ok := mod.(*object.Error); ok {
+		// The error is in the module's code, so the calls that led to it
+		// stop at this wrangle
+		if errObj.Stack == nil {
+			errObj.Stack = stackTrace(stmt.ModuleName.Token.Line, stmt.ModuleName.Token.Column, env.Call())
+		}
+		return errObj
+	}
 
 	// Store module in environment
 	env.Set(moduleName, mod)
@@ -427,32 +1005,251 @@ func evalWrangleStatement(stmt *ast.WrangleStatement, env *Environment) object.O
 func evalMemberAccessExpression(expr *ast.MemberAccessExpression, env *Environment) object.Object {
 	// Evaluate the object (left side)
 	obj := Eval(expr.Object, env)
+	if isError(obj) {
+		return obj
+	}
 
 	// Check if it's a module
 	if mod, ok := obj.(*object.Module); ok {
 		member, found := mod.Get(expr.Member.Value)
 		if !found {
-			return object.NULL
+			return newError(expr.Member.Token, "module %s has no member %s (available: %s)",
+				mod.Name, expr.Member.Value, strings.Join(mod.MemberNames(), ", "))
 		}
 		return member
 	}
 
-	return object.NULL
+	if instance, ok := obj.(*object.Instance); ok {
+		member, found := instance.Get(expr.Member.Value)
+		if !found {
+			return newError(expr.Member.Token, "%s has no field or method %s (available: %s)",
+				instance.Struct.Name, expr.Member.Value, strings.Join(instance.MemberNames(), ", "))
+		}
+		return member
+	}
+
+	if errVal, ok := obj.(*object.ErrorValue); ok {
+		member, found := errVal.Get(expr.Member.Value)
+		if !found {
+			return newError(expr.Member.Token, "error has no member %s (available: %s)",
+				expr.Member.Value, strings.Join(errVal.MemberNames(), ", "))
+		}
+		return member
+	}
+
+	return newError(expr.Member.Token, "cannot access member %s on %s", expr.Member.Value, obj.Type())
+}
+
+// evalStructDeclaration creates a congregation type and stores it in the environment.
+// Methods close over the declaring scope, just like functions.
+func evalStructDeclaration(decl *ast.StructDeclaration, env *Environment) object.Object {
+	if env.DeclaresConstant(decl.Name.Value) {
+		return newError(decl.Name.Token, "cannot redeclare constant: %s", decl.Name.Value)
+	}
+
+	st := &object.StructType{
+		Name:    decl.Name.Value,
+		Fields:  make([]string, len(decl.Fields)),
+		Methods: make(map[string]*object.Function, len(decl.Methods)),
+	}
+
+	seen := make(map[string]bool)
+	for i, field := range decl.Fields {
+		if seen[field.Value] {
+			return newError(field.Token, "duplicate field %s in congregation %s", field.Value, st.Name)
+		}
+		seen[field.Value] = true
+		st.Fields[i] = field.Value
+	}
+
+	for _, method := range decl.Methods {
+		if seen[method.Name.Value] {
+			return newError(method.Name.Token, "duplicate field or method %s in congregation %s", method.Name.Value, st.Name)
+		}
+		seen[method.Name.Value] = true
+		st.Methods[method.Name.Value] = &object.Function{
+			Name:       st.Name + "." + method.Name.Value,
+			Parameters: method.Parameters,
+			Defaults:   method.Defaults,
+			Rest:       method.Rest,
+			Body:       method.Body,
+			Env:        env,
+		}
+	}
+
+	env.Set(st.Name, st)
+	return st
 }
 
-// loadModule creates and returns a module by name
-// For now, this is hardcoded - later we can make it extensible
-func loadModule(name string) *object.Module {
-	switch name {
-	case "io":
-		return createIOModule()
+// evalMemberAssignmentStatement assigns to a field of an instance: steak.weight = 12
+// Only declared fields can be assigned; instances cannot grow new ones.
+func evalMemberAssignmentStatement(stmt *ast.MemberAssignmentStatement, env *Environment) object.Object {
+	obj := Eval(stmt.Target.Object, env)
+	if isError(obj) {
+		return obj
+	}
+
+	member := stmt.Target.Member
+	instance, ok := obj.(*object.Instance)
+	if !ok {
+		return newError(member.Token, "cannot assign to member %s of %s", member.Value, obj.Type())
+	}
+
+	if _, isField := instance.Fields[member.Value]; !isField {
+		return newError(member.Token, "%s has no field %s (fields: %s)",
+			instance.Struct.Name, member.Value, strings.Join(instance.Struct.Fields, ", "))
+	}
+
+	val := Eval(stmt.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	instance.Fields[member.Value] = val
+	return val
+}
+
+// evalArrayLiteral evaluates each element expression and collects them into an Array
+func evalArrayLiteral(node *ast.ArrayLiteral, env *Environment) object.Object {
+	elements := evalExpressions(node.Elements, env)
+	if len(elements) == 1 && isError(elements[0]) {
+		return elements[0]
+	}
+	return &object.Array{Elements: elements}
+}
+
+// evalIndexExpression evaluates arr[i] and str[i]
+func evalIndexExpression(node *ast.IndexExpression, env *Environment) object.Object {
+	left := Eval(node.Left, env)
+	if isError(left) {
+		return left
+	}
+	index := Eval(node.Index, env)
+	if isError(index) {
+		return index
+	}
+
+	switch {
+	case left.Type() == "HASH":
+		return evalHashIndexExpression(node.Token, left.(*object.Hash), index)
+	case left.Type() == "ARRAY" && index.Type() == "INTEGER":
+		return evalArrayIndexExpression(node.Token, left.(*object.Array), index.(*object.Integer))
+	case left.Type() == "STRING" && index.Type() == "INTEGER":
+		return evalStringIndexExpression(node.Token, left.(*object.String), index.(*object.Integer))
+	case left.Type() == "ARRAY" || left.Type() == "STRING":
+		return newError(node.Token, "index must be an INTEGER, got %s", index.Type())
 	default:
-		// Return empty module for unknown modules
-		return &object.Module{
-			Name:    name,
-			Members: make(map[string]object.Object),
+		return newError(node.Token, "index operator not supported: %s", left.Type())
+	}
+}
+
+// evalArrayIndexExpression returns the element at the given index, or an error if out of bounds
+func evalArrayIndexExpression(tok token.Token, array *object.Array, index *object.Integer) object.Object {
+	idx := index.Value
+	length := int64(len(array.Elements))
+	if idx < 0 || idx >= length {
+		return newError(tok, "index out of bounds: index %d, length %d", idx, length)
+	}
+	return array.Elements[idx]
+}
+
+// evalStringIndexExpression returns the single-character string at the given index
+func evalStringIndexExpression(tok token.Token, str *object.String, index *object.Integer) object.Object {
+	// Strings are indexed by character, so multibyte characters stay whole
+	idx := index.Value
+	if length := str.Len(); idx < 0 || idx >= length {
+		return newError(tok, "index out of bounds: index %d, length %d", idx, length)
+	}
+	// Walk the characters up to idx instead of decoding the whole string
+	for offset := 0; ; idx-- {
+		ch, width := utf8.DecodeRuneInString(str.Value[offset:])
+		if idx == 0 {
+			return &object.String{Value: string(ch)}
+		}
+		offset += width
+	}
+}
+
+// evalHashIndexExpression looks up a key in a hash, returning NULL for missing keys
+func evalHashIndexExpression(tok token.Token, hash *object.Hash, index object.Object) object.Object {
+	key, ok := index.(object.Hashable)
+	if !ok {
+		return newError(tok, "unusable as hash key: %s", index.Type())
+	}
+
+	val, found := hash.Get(key)
+	if !found {
+		return object.NULL
+	}
+	return val
+}
+
+// evalHashLiteral evaluates each key/value pair in source order and builds a Hash
+func evalHashLiteral(node *ast.HashLiteral, env *Environment) object.Object {
+	hash := object.NewHash()
+
+	for _, pair := range node.Pairs {
+		key := Eval(pair.Key, env)
+		if isError(key) {
+			return key
+		}
+
+		hashKey, ok := key.(object.Hashable)
+		if !ok {
+			return newError(pair.Token, "unusable as hash key: %s", key.Type())
+		}
+
+		value := Eval(pair.Value, env)
+		if isError(value) {
+			return value
+		}
+
+		hash.Set(hashKey, value)
+	}
+
+	return hash
+}
+
+// evalIndexAssignmentStatement handles element assignment (arr[0] = value, h["key"] = value)
+// Arrays and hashes are mutated in place, so every reference to them sees the change
+func evalIndexAssignmentStatement(stmt *ast.IndexAssignmentStatement, env *Environment) object.Object {
+	left := Eval(stmt.Target.Left, env)
+	if isError(left) {
+		return left
+	}
+	index := Eval(stmt.Target.Index, env)
+	if isError(index) {
+		return index
+	}
+	val := Eval(stmt.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	switch target := left.(type) {
+	case *object.Array:
+		idx, ok := index.(*object.Integer)
+		if !ok {
+			return newError(stmt.Target.Token, "index must be an INTEGER, got %s", index.Type())
+		}
+		length := int64(len(target.Elements))
+		if idx.Value < 0 || idx.Value >= length {
+			return newError(stmt.Target.Token, "index out of bounds: index %d, length %d", idx.Value, length)
+		}
+		target.Elements[idx.Value] = val
+
+	case *object.Hash:
+		key, ok := index.(object.Hashable)
+		if !ok {
+			return newError(stmt.Target.Token, "unusable as hash key: %s", index.Type())
 		}
+		target.Set(key, val)
+
+	default:
+		return newError(stmt.Target.Token, "index assignment not supported: %s", left.Type())
 	}
+
+	return val
 }
 
 func createIOModule() *object.Module {
@@ -491,6 +1288,105 @@ func createIOModule() *object.Module {
 	return mod
 }
 
+// createErrorsModule builds the errors module, which lets Beeflang code create
+// and inspect errors as values. An error value is raised with smite.
+func createErrorsModule() *object.Module {
+	mod := &object.Module{
+		Name:    "errors",
+		Members: make(map[string]object.Object),
+	}
+
+	// new - an error value with the given message, located at the call
+	mod.Set("new", &object.Builtin{
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return builtinError("wrong number of arguments to errors.new: expected 1, got %d", len(args))
+			}
+			message, ok := args[0].(*object.String)
+			if !ok {
+				return builtinError("argument to errors.new must be STRING, got %s", args[0].Type())
+			}
+			return &object.ErrorValue{Err: &object.Error{Message: message.Value}}
+		},
+	})
+
+	// wrap - adds context to an error: "context: original message"
+	// The result keeps the original location and remembers the original as its cause
+	mod.Set("wrap", &object.Builtin{
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 2 {
+				return builtinError("wrong number of arguments to errors.wrap: expected 2, got %d", len(args))
+			}
+			inner, ok := args[0].(*object.ErrorValue)
+			if !ok {
+				return builtinError("first argument to errors.wrap must be ERROR_VALUE, got %s", args[0].Type())
+			}
+			context, ok := args[1].(*object.String)
+			if !ok {
+				return builtinError("second argument to errors.wrap must be STRING, got %s", args[1].Type())
+			}
+			return &object.ErrorValue{Err: &object.Error{
+				Message: context.Value + ": " + inner.Err.Message,
+				Line:    inner.Err.Line,
+				Column:  inner.Err.Column,
+				File:    inner.Err.File,
+				Cause:   inner.Err,
+				Stack:   inner.Err.Stack,
+			}}
+		},
+	})
+
+	// message - the text of an error
+	mod.Set("message", &object.Builtin{
+		Fn: func(args ...object.Object) object.Object {
+			errVal, errObj := errorValueArg("errors.message", args)
+			if errObj != nil {
+				return errObj
+			}
+			return &object.String{Value: errVal.Err.Message}
+		},
+	})
+
+	// cause - the error wrapped by errors.wrap, or NULL
+	mod.Set("cause", &object.Builtin{
+		Fn: func(args ...object.Object) object.Object {
+			errVal, errObj := errorValueArg("errors.cause", args)
+			if errObj != nil {
+				return errObj
+			}
+			cause, _ := errVal.Get("cause")
+			return cause
+		},
+	})
+
+	// is_error - whether a value is an error value
+	mod.Set("is_error", &object.Builtin{
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return builtinError("wrong number of arguments to errors.is_error: expected 1, got %d", len(args))
+			}
+			if _, ok := args[0].(*object.ErrorValue); ok {
+				return object.TRUE
+			}
+			return object.FALSE
+		},
+	})
+
+	return mod
+}
+
+// errorValueArg checks that a builtin received exactly one error value
+func errorValueArg(name string, args []object.Object) (*object.ErrorValue, *object.Error) {
+	if len(args) != 1 {
+		return nil, builtinError("wrong number of arguments to %s: expected 1, got %d", name, len(args))
+	}
+	errVal, ok := args[0].(*object.ErrorValue)
+	if !ok {
+		return nil, builtinError("argument to %s must be ERROR_VALUE, got %s", name, args[0].Type())
+	}
+	return errVal, nil
+}
+
 // ========================================
 // Error Handling Helpers
 // ========================================
@@ -504,7 +1400,42 @@ func newError(tok token.Token, format string, a ...interface{}) *object.Error {
 		Message: fmt.Sprintf(format, a...),
 		Line:    tok.Line,
 		Column:  tok.Column,
-		// File is set by main.go when running from a file
+		// File is set when the error leaves code from another file: see
+		// loadModuleFile and callFunction
+	}
+}
+
+// locateError fills in the location of an error created without a token
+func locateError(errObj *object.Error, tok token.Token) {
+	if errObj.Line == 0 {
+		errObj.Line = tok.Line
+		errObj.Column = tok.Column
+	}
+}
+
+// stackTrace lists call and the calls it was made from, innermost first.
+// The innermost frame is at line and column (where the error was raised);
+// each outer frame is at the call it made to the frame inside it.
+func stackTrace(line, column int, call *object.Call) []object.Frame {
+	var trace []object.Frame
+	for ; call != nil; call = call.Caller {
+		trace = append(trace, object.Frame{Function: call.Function, Line: line, Column: column, File: call.File})
+		line, column = call.Line, call.Column
+	}
+	return trace
+}
+
+// isControlSignal checks if an object unwinds enclosing blocks: a return
+// value, or a break/continue signal travelling up to its loop.
+func isControlSignal(obj object.Object) bool {
+	if obj == nil {
+		return false
+	}
+	switch obj.Type() {
+	case "RETURN_VALUE", "BREAK_SIGNAL", "CONTINUE_SIGNAL":
+		return true
+	default:
+		return false
 	}
 }
 
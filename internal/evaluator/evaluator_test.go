@@ -1,6 +1,7 @@
 package evaluator
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/elitwilson/beeflang/internal/lexer"
@@ -351,6 +352,71 @@ a + b
 	}
 }
 
+func TestEvalFunctionLiteral(t *testing.T) {
+	result := testEval(`praise(x): serve x * 2 beef`)
+
+	fn, ok := result.(*object.Function)
+	assert.True(t, ok, "Result should be a Function object")
+	assert.Len(t, fn.Parameters, 1)
+	assert.Equal(t, "x", fn.Parameters[0].Value)
+	assert.NotNil(t, fn.Env, "Function literal should capture its environment")
+}
+
+func TestEvalFunctionLiteralUsage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		// Variable initializer
+		{`
+prep double = praise(x): serve x * 2 beef
+double(21)
+`, 42},
+		// Call argument
+		{`
+praise apply(f, value):
+   serve f(value)
+beef
+apply(praise(x): serve x + 1 beef, 41)
+`, 42},
+		// Return value capturing the enclosing parameter
+		{`
+praise adder(n):
+   serve praise(x): serve x + n beef
+beef
+prep addTen = adder(10)
+addTen(32)
+`, 42},
+		// Called straight from the return value
+		{`
+praise adder(n):
+   serve praise(x): serve x + n beef
+beef
+adder(40)(2)
+`, 42},
+		// Immediately invoked
+		{`praise(x): serve x * x beef(7)`, 49},
+		// Multi-line body
+		{`
+prep sum = praise(a, b):
+   prep total = a + b
+   serve total
+beef
+sum(40, 2)
+`, 42},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "Result should be an Integer for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
 func TestEvalFunctionWithoutReturn(t *testing.T) {
 	// Function without explicit serve should return NULL
 	input := `
@@ -528,6 +594,359 @@ func TestUndefinedVariableError(t *testing.T) {
 	assert.Contains(t, errObj.Message, "foobar")
 }
 
+func TestClosuresMutateCapturedVariables(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		// Counter: each call updates the captured count
+		{`
+praise makeCounter():
+   prep count = 0
+   serve praise():
+      count = count + 1
+      serve count
+   beef
+beef
+prep counter = makeCounter()
+counter()
+counter()
+counter()
+`, 3},
+		// Separate counters do not share state
+		{`
+praise makeCounter():
+   prep count = 0
+   serve praise():
+      count = count + 1
+      serve count
+   beef
+beef
+prep a = makeCounter()
+prep b = makeCounter()
+a()
+a()
+b()
+a() * 10 + b()
+`, 32},
+		// Named functions update globals instead of shadowing them
+		{`
+prep total = 0
+praise add(n):
+   total = total + n
+beef
+add(5)
+add(7)
+total
+`, 12},
+		// Accumulator passed as a callback
+		{`
+praise each(arr, f):
+   feast for x in arr:
+      f(x)
+   beef
+beef
+prep sum = 0
+each([1, 2, 3, 4], praise(x): sum = sum + x beef)
+sum
+`, 10},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "Result should be an Integer for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestAssignToUndeclaredVariableError(t *testing.T) {
+	tests := []string{
+		"x = 5",
+		`
+praise f():
+   y = 1
+beef
+f()
+`,
+	}
+
+	for _, input := range tests {
+		result := testEval(input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T", input, result)
+		if ok {
+			assert.Contains(t, errObj.Message, "undeclared variable")
+		}
+	}
+}
+
+func TestAssignmentPropagatesValueErrors(t *testing.T) {
+	result := testEval(`
+prep x = 1
+x = 1 + true
+`)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	if ok {
+		assert.Contains(t, errObj.Message, "type mismatch")
+	}
+}
+
+func TestBlockScoping(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int64
+	}{
+		{"if body sees outer variables", `
+prep x = 5
+prep y = 0
+if true:
+   y = x * 2
+beef
+y
+`, 10},
+		{"prep in if body shadows outer variable", `
+prep x = 1
+if true:
+   prep x = 99
+beef
+x
+`, 1},
+		{"shadow is visible inside the block", `
+prep x = 1
+prep seen = 0
+if true:
+   prep x = 99
+   seen = x
+beef
+seen
+`, 99},
+		{"else and else-if bodies are scoped too", `
+prep x = 1
+if false:
+   prep x = 2
+else if false:
+   prep x = 3
+else:
+   prep x = 4
+beef
+x
+`, 1},
+		{"while body updates outer variable", `
+prep i = 0
+prep sum = 0
+feast while i < 4:
+   i = i + 1
+   sum = sum + i
+beef
+sum
+`, 10},
+		{"prep in while body gets a fresh binding each pass", `
+prep i = 0
+prep total = 0
+feast while i < 3:
+   prep step = 10
+   step = step + i
+   total = total + step
+   i = i + 1
+beef
+total
+`, 33},
+		{"nested blocks assign to the declaring scope", `
+prep x = 0
+if true:
+   prep y = 1
+   if true:
+      x = y + 41
+   beef
+beef
+x
+`, 42},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "%s: result should be an Integer, got %T (%+v)", tt.name, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, tt.name)
+		}
+	}
+}
+
+func TestBlockVariablesDoNotLeak(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"if body", `
+if true:
+   prep inner = 1
+beef
+inner
+`},
+		{"else body", `
+if false:
+   prep a = 1
+else:
+   prep inner = 2
+beef
+inner
+`},
+		{"while body", `
+prep i = 0
+feast while i < 1:
+   prep inner = i
+   i = i + 1
+beef
+inner
+`},
+		{"if inside function", `
+praise f():
+   if true:
+      prep inner = 1
+   beef
+   serve inner
+beef
+f()
+`},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "%s: expected error object, got %T (%+v)", tt.name, result, result)
+		if ok {
+			assert.Contains(t, errObj.Message, "identifier not found: inner", tt.name)
+		}
+	}
+}
+
+func TestEvalConstantDeclaration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"pack x = 42\nx", 42},
+		{"pack base = 10\nprep y = base * 2\ny", 20},
+		// Constants are readable from functions
+		{`
+pack rate = 3
+praise triple(n):
+   serve n * rate
+beef
+triple(5)
+`, 15},
+		// An inner prep may shadow a constant
+		{`
+pack x = 1
+praise f():
+   prep x = 5
+   x = x + 1
+   serve x
+beef
+f() + x
+`, 7},
+		// Elements of a packed array can still change; only the binding is fixed
+		{`
+pack arr = [1, 2, 3]
+arr[0] = 10
+arr[0]
+`, 10},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "Result should be an Integer for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestConstantReassignmentErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		line     int
+		column   int
+	}{
+		// Reassignment the parser cannot see: the function is declared
+		// before the constant exists
+		{`
+praise reset():
+   limit = 0
+beef
+pack limit = 10
+reset()
+`, "cannot reassign constant: limit", 3, 4},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := parser.New(l)
+		program := p.ParseProgram()
+		assert.Empty(t, p.Errors(), "Input: %s", tt.input)
+
+		result := Eval(program, NewEnvironment())
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, tt.line, errObj.Line, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+	}
+}
+
+// Redeclarations the parser cannot see: like REPL lines, the constant and
+// the redeclaration are parsed separately and run in the same environment
+func TestConstantRedeclarationErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		column int
+	}{
+		{"prep x = 2", 6},
+		{"pack x = 2", 6},
+		{"praise x(): serve 2 beef", 8},
+		{"congregation x(a)", 14},
+		{"wrangle x", 9},
+	}
+
+	for _, tt := range tests {
+		env := NewEnvironment()
+		Eval(parser.New(lexer.New("pack x = 1")).ParseProgram(), env)
+
+		p := parser.New(lexer.New(tt.input))
+		program := p.ParseProgram()
+		assert.Empty(t, p.Errors(), "Input: %s", tt.input)
+
+		result := Eval(program, env)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, "cannot redeclare constant: x", errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+
+		val, _ := env.Get("x")
+		assert.Equal(t, "1", val.Inspect(), "the constant should keep its value after: %s", tt.input)
+	}
+}
+
 func TestUnknownOperatorError(t *testing.T) {
 	tests := []struct {
 		input string
@@ -596,3 +1015,1887 @@ prep result = badFunc()
 	assert.True(t, ok, "Expected error object")
 	assert.Contains(t, errObj.Message, "type mismatch")
 }
+
+// ========================================
+// Arrays and Indexing Tests
+// ========================================
+
+func TestEvalArrayLiterals(t *testing.T) {
+	result := testEval("[1, 2 * 2, 3 + 3]")
+
+	array, ok := result.(*object.Array)
+	assert.True(t, ok, "Result should be an Array, got %T", result)
+	assert.Len(t, array.Elements, 3)
+	assert.Equal(t, int64(1), array.Elements[0].(*object.Integer).Value)
+	assert.Equal(t, int64(4), array.Elements[1].(*object.Integer).Value)
+	assert.Equal(t, int64(6), array.Elements[2].(*object.Integer).Value)
+}
+
+func TestEvalArrayIndexExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"[1, 2, 3][0]", 1},
+		{"[1, 2, 3][2]", 3},
+		{"prep i = 1\n[1, 2, 3][i]", 2},
+		{"[1, 2, 3][1 + 1]", 3},
+		{"prep arr = [10, 20, 30]\narr[0] + arr[1]", 30},
+		{"prep grid = [[1, 2], [3, 4]]\ngrid[1][0]", 3},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "Result should be an Integer for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalArrayIndexOutOfBounds(t *testing.T) {
+	tests := []string{
+		"[1, 2, 3][3]",
+		"[1, 2, 3][-1]",
+		"[][0]",
+	}
+
+	for _, input := range tests {
+		result := testEval(input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T", input, result)
+		if ok {
+			assert.Contains(t, errObj.Message, "index out of bounds", "Input: %s", input)
+			assert.Greater(t, errObj.Line, 0, "Error should have line number")
+		}
+	}
+}
+
+func TestEvalStringIndexExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"hello"[0]`, "h"},
+		{`"hello"[4]`, "o"},
+		{"prep greeting = \"beef\"\ngreeting[1]", "e"},
+		// Indexing counts characters, not bytes
+		{`"café"[3]`, "é"},
+		{`"🥩 steak"[0]`, "🥩"},
+		{`"🥩 steak"[2]`, "s"},
+		{`"steak 🥩"[6]`, "🥩"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		str, ok := result.(*object.String)
+		assert.True(t, ok, "Result should be a String for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, str.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalStringIndexOutOfBounds(t *testing.T) {
+	result := testEval(`"beef"[4]`)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	assert.Contains(t, errObj.Message, "index out of bounds: index 4, length 4")
+
+	result = testEval(`"crème"[5]`)
+	errObj, ok = result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	assert.Contains(t, errObj.Message, "index out of bounds: index 5, length 5")
+
+	result = testEval(`"beef"[-1]`)
+	errObj, ok = result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	assert.Contains(t, errObj.Message, "index out of bounds: index -1, length 4")
+}
+
+func TestArraysWithMixedTypes(t *testing.T) {
+	input := `
+prep mixed = [42, "beef", true, [1, 2]]
+mixed
+`
+	result := testEval(input)
+
+	array, ok := result.(*object.Array)
+	assert.True(t, ok, "Result should be an Array")
+	assert.Equal(t, "[42, beef, true, [1, 2]]", array.Inspect())
+}
+
+func TestEvalIndexAssignment(t *testing.T) {
+	input := `
+prep numbers = [1, 2, 3]
+prep alias = numbers
+numbers[0] = 99
+alias[0] + numbers[2]
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(102), integer.Value, "assignment should mutate the shared array")
+}
+
+func TestIndexErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{`[1, 2]["a"]`, "index must be an INTEGER, got STRING"},
+		{"5[0]", "index operator not supported: INTEGER"},
+		{"prep arr = [1]\narr[5] = 2", "index out of bounds: index 5, length 1"},
+		{"prep s = \"beef\"\ns[0] = \"r\"", "index assignment not supported: STRING"},
+		{"[missing][0]", "identifier not found: missing"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Contains(t, errObj.Message, tt.expectedMessage, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestBuiltinLenAndPush(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{"len([1, 2, 3])", int64(3)},
+		{"len([])", int64(0)},
+		{`len("beef")`, int64(4)},
+		{`len("crème brûlée")`, int64(12)},
+		{`len("🥩")`, int64(1)},
+		{"len(push([1, 2], 3))", int64(3)},
+		{"prep a = [1]\nprep b = push(a, 2)\nlen(a)", int64(1)},
+		{"len(1)", "argument to len not supported: INTEGER"},
+		{"len([1], [2])", "wrong number of arguments to len: expected 1, got 2"},
+		{"push(1, 2)", "first argument to push must be ARRAY, got INTEGER"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int64:
+			integer, ok := result.(*object.Integer)
+			assert.True(t, ok, "Result should be an Integer for input: %s, got %T", tt.input, result)
+			if ok {
+				assert.Equal(t, expected, integer.Value, "Input: %s", tt.input)
+			}
+		case string:
+			errObj, ok := result.(*object.Error)
+			assert.True(t, ok, "Expected error for input: %s, got %T", tt.input, result)
+			if ok {
+				assert.Equal(t, expected, errObj.Message, "Input: %s", tt.input)
+				assert.Greater(t, errObj.Line, 0, "Builtin errors should be located at the call site")
+			}
+		}
+	}
+}
+
+// ========================================
+// Hash Tests
+// ========================================
+
+func TestEvalHashLiterals(t *testing.T) {
+	input := `
+prep two = "two"
+{"one": 10 - 9, two: 1 + 1, "thr" + "ee": 6 / 2, 4: 4, true: 5, false: 6}
+`
+	result := testEval(input)
+
+	hash, ok := result.(*object.Hash)
+	assert.True(t, ok, "Result should be a Hash, got %T", result)
+
+	expected := map[object.HashKey]int64{
+		(&object.String{Value: "one"}).HashKey():   1,
+		(&object.String{Value: "two"}).HashKey():   2,
+		(&object.String{Value: "three"}).HashKey(): 3,
+		(&object.Integer{Value: 4}).HashKey():      4,
+		object.TRUE.HashKey():                      5,
+		object.FALSE.HashKey():                     6,
+	}
+
+	assert.Len(t, hash.Pairs, len(expected))
+	for key, value := range expected {
+		pair, ok := hash.Pairs[key]
+		assert.True(t, ok, "no pair for key %v", key)
+		assert.Equal(t, value, pair.Value.(*object.Integer).Value)
+	}
+}
+
+func TestEvalHashIndexExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected interface{}
+	}{
+		{`{"foo": 5}["foo"]`, int64(5)},
+		{`{"foo": 5}["bar"]`, nil},
+		{"prep key = \"foo\"\n{\"foo\": 5}[key]", int64(5)},
+		{`{}["foo"]`, nil},
+		{`{5: 5}[5]`, int64(5)},
+		{`{true: 5}[true]`, int64(5)},
+		{`{false: 5}[false]`, int64(5)},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int64:
+			integer, ok := result.(*object.Integer)
+			assert.True(t, ok, "Result should be an Integer for input: %s, got %T", tt.input, result)
+			if ok {
+				assert.Equal(t, expected, integer.Value, "Input: %s", tt.input)
+			}
+		case nil:
+			assert.Equal(t, object.NULL, result, "Missing key should be NULL for input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalHashIndexAssignment(t *testing.T) {
+	input := `
+prep prices = {"brisket": 20}
+prices["brisket"] = 25
+prices["ribs"] = 18
+prices
+`
+	result := testEval(input)
+
+	hash, ok := result.(*object.Hash)
+	assert.True(t, ok, "Result should be a Hash, got %T", result)
+	assert.Equal(t, "{brisket: 25, ribs: 18}", hash.Inspect())
+}
+
+func TestUnhashableKeyErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{`{[1]: "array"}`, "unusable as hash key: ARRAY"},
+		{`{"a": 1}[{"b": 2}]`, "unusable as hash key: HASH"},
+		{"praise f():\nbeef\n{f: 1}", "unusable as hash key: FUNCTION"},
+		{"praise f():\nbeef\nprep h = {}\nh[f] = 1", "unusable as hash key: FUNCTION"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expectedMessage, errObj.Message, "Input: %s", tt.input)
+			assert.Greater(t, errObj.Line, 0, "Error should have line number")
+		}
+	}
+}
+
+func TestUnhashableKeyErrorLocation(t *testing.T) {
+	input := `prep brisket = [1]
+prep prices = {
+   "ribs": 18,
+   brisket: 20,
+}`
+
+	result := testEval(input)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "unusable as hash key: ARRAY", errObj.Message)
+		assert.Equal(t, 4, errObj.Line, "the error should point at the key, not the '{'")
+		assert.Equal(t, 4, errObj.Column)
+	}
+}
+
+func TestBuiltinLenOfHash(t *testing.T) {
+	result := testEval(`len({"a": 1, "b": 2})`)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(2), integer.Value)
+}
+
+// ========================================
+// Float Tests
+// ========================================
+
+func TestEvalFloatExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"3.14", 3.14},
+		{"-2.5", -2.5},
+		{"1.5 + 1.5", 3.0},
+		{"5.5 - 0.5", 5.0},
+		{"2.5 * 2.0", 5.0},
+		{"7.0 / 2.0", 3.5},
+		{"7.5 % 2.0", 1.5},
+
+		// Mixed arithmetic promotes the INTEGER operand to FLOAT
+		{"7 / 2.0", 3.5},
+		{"7.0 / 2", 3.5},
+		{"1 + 0.5", 1.5},
+		{"0.5 * 4", 2.0},
+		{"prep total = 10\nprep count = 4\ntotal * 1.0 / count", 2.5},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		f, ok := result.(*object.Float)
+		assert.True(t, ok, "Result should be a Float for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.InDelta(t, tt.expected, f.Value, 1e-9, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestIntegerDivisionStaysInteger(t *testing.T) {
+	result := testEval("7 / 2")
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "INTEGER / INTEGER should stay an Integer, got %T", result)
+	assert.Equal(t, int64(3), integer.Value)
+}
+
+func TestEvalMixedNumericComparisons(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"1 == 1.0", true},
+		{"1.0 == 1", true},
+		{"1 != 1.5", true},
+		{"0.5 < 1", true},
+		{"2 > 1.5", true},
+		{"2.0 <= 2", true},
+		{"2 >= 2.5", false},
+		{"0.1 + 0.2 > 0.3", true},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		boolean, ok := result.(*object.Boolean)
+		assert.True(t, ok, "Result should be a Boolean for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, boolean.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestDivisionByZero(t *testing.T) {
+	tests := []struct {
+		input  string
+		line   int
+		column int
+	}{
+		{"10 / 0", 1, 4},
+		{"10 % 0", 1, 4},
+		{"prep zero = 0\nprep x = 5 / zero", 2, 12},
+		{"1.5 / 0", 1, 5},
+		{"1 / 0.0", 1, 3},
+		{"7.5 % 0.0", 1, 5},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, "division by zero", errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, tt.line, errObj.Line, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestDivisionByZeroIsCatchable(t *testing.T) {
+	input := `
+prep message = ""
+try:
+   prep x = 1 / 0
+catch err:
+   message = err.message
+beef
+message`
+
+	result := testEval(input)
+
+	str, ok := result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "division by zero", str.Value)
+	}
+}
+
+func TestFloatTypeErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"1.5 + true", "type mismatch: FLOAT + BOOLEAN"},
+		{`"beef" + 1.5`, "type mismatch: STRING + FLOAT"},
+		{"-true", "unknown operator: -BOOLEAN"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expectedMessage, errObj.Message, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestBuiltinNumericConversions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"float(3)", "3.0"},
+		{"float(2.5)", "2.5"},
+		{"int(3.9)", "3"},
+		{"int(-3.9)", "-3"},
+		{"int(7)", "7"},
+		{"float(7) / 2", "3.5"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+		assert.Equal(t, tt.expected, result.Inspect(), "Input: %s", tt.input)
+	}
+}
+
+// ========================================
+// Logical Operator Tests
+// ========================================
+
+func TestEvalLogicalOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"true and true", true},
+		{"true and false", false},
+		{"false or true", true},
+		{"false or false", false},
+		{"true && false", false},
+		{"false || true", true},
+		{"not true", false},
+		{"not false", true},
+		{"not 1 == 2", true},
+		{"1 < 2 and 2 < 3", true},
+		{"1 > 2 or 2 > 3", false},
+		{"true or false and false", true},
+		{"(true or false) and false", false},
+		{"not (true and false)", true},
+
+		// Operands use truthiness, the result is always a Boolean
+		{"5 and \"beef\"", true},
+		{"0 or false", true},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		boolean, ok := result.(*object.Boolean)
+		assert.True(t, ok, "Result should be a Boolean for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, boolean.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestLogicalOperatorsShortCircuit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		// The right side would crash (division by zero) or error if evaluated
+		{"prep x = 0\nx != 0 and 10 / x > 1", false},
+		{"prep x = 0\nx == 0 or 10 / x > 1", true},
+		{"false and undefined_thing", false},
+		{"true or undefined_thing", true},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		boolean, ok := result.(*object.Boolean)
+		assert.True(t, ok, "Result should be a Boolean for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, boolean.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestLogicalOperatorsPropagateErrors(t *testing.T) {
+	tests := []string{
+		"true and undefined_thing",
+		"false or undefined_thing",
+		"undefined_thing and true",
+	}
+
+	for _, input := range tests {
+		result := testEval(input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T", input, result)
+		if ok {
+			assert.Contains(t, errObj.Message, "identifier not found: undefined_thing")
+		}
+	}
+}
+
+func TestLogicalOperatorsInIfCondition(t *testing.T) {
+	input := `
+prep a = 5
+prep b = 10
+if a > 0 and b > a:
+   1
+else:
+   2
+beef
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(1), integer.Value)
+}
+
+// ========================================
+// Else-If Chain Tests
+// ========================================
+
+func TestEvalElseIfChain(t *testing.T) {
+	classify := `
+praise classify(n):
+   if n < 0:
+      serve "negative"
+   else if n == 0:
+      serve "zero"
+   else if n < 10:
+      serve "small"
+   else:
+      serve "large"
+   beef
+beef
+`
+	tests := []struct {
+		call     string
+		expected string
+	}{
+		{"classify(-5)", "negative"},
+		{"classify(0)", "zero"},
+		{"classify(7)", "small"},
+		{"classify(100)", "large"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(classify + tt.call)
+
+		str, ok := result.(*object.String)
+		assert.True(t, ok, "Result should be a String for %s, got %T", tt.call, result)
+		if ok {
+			assert.Equal(t, tt.expected, str.Value, tt.call)
+		}
+	}
+}
+
+func TestEvalElseIfOnlyFirstMatchRuns(t *testing.T) {
+	input := `
+prep hits = 0
+prep x = 5
+if x > 10:
+   hits = hits + 100
+else if x > 1:
+   hits = hits + 1
+else if x > 0:
+   hits = hits + 10
+beef
+hits
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(1), integer.Value, "only the first matching branch should run")
+}
+
+func TestEvalElseIfNoMatchWithoutElse(t *testing.T) {
+	result := testEval("if false: 1 else if false: 2 beef")
+	assert.Equal(t, object.NULL, result)
+}
+
+func TestEvalHeresyAlias(t *testing.T) {
+	result := testEval("if false: 1 heresy if true: 2 heresy: 3 beef")
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(2), integer.Value)
+}
+
+// ========================================
+// Loop Control Tests
+// ========================================
+
+func TestEvalLoopControl(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		// sacrifice leaves the loop immediately
+		{`
+prep i = 0
+feast while true:
+   i = i + 1
+   if i == 5:
+      sacrifice
+   beef
+beef
+i
+`, 5},
+		// repent skips the rest of the body (sum of odd numbers 1..9)
+		{`
+prep sum = 0
+prep i = 0
+feast while i < 10:
+   i = i + 1
+   if i % 2 == 0:
+      repent
+   beef
+   sum = sum + i
+beef
+sum
+`, 25},
+		// sacrifice only leaves the innermost loop
+		{`
+prep count = 0
+prep outer = 0
+feast while outer < 3:
+   outer = outer + 1
+   prep inner = 0
+   feast while true:
+      inner = inner + 1
+      count = count + 1
+      if inner == 2:
+         sacrifice
+      beef
+   beef
+beef
+count
+`, 6},
+		// sacrifice unwinds through else-if chains
+		{`
+prep i = 0
+feast while i < 100:
+   i = i + 1
+   if i < 3:
+      repent
+   else if i == 7:
+      sacrifice
+   beef
+beef
+i
+`, 7},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "Result should be an Integer for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalReturnFromInsideLoop(t *testing.T) {
+	input := `
+praise firstOver(limit):
+   prep i = 0
+   feast while true:
+      i = i + 1
+      if i * i > limit:
+         serve i
+      beef
+   beef
+beef
+firstOver(50)
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(8), integer.Value)
+}
+
+// ========================================
+// For-Each Loop Tests
+// ========================================
+
+func TestEvalForLoop(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		// Integer range (end is exclusive)
+		{`
+prep sum = 0
+feast for i in range(1, 6):
+   sum = sum + i
+beef
+sum
+`, 15},
+		// range(end) starts at zero
+		{`
+prep count = 0
+feast for i in range(4):
+   count = count + 1
+beef
+count
+`, 4},
+		// Stepped and descending ranges
+		{`
+prep sum = 0
+feast for i in range(10, 0, -3):
+   sum = sum + i
+beef
+sum
+`, 22}, // 10 + 7 + 4 + 1
+		// Arrays
+		{`
+prep total = 0
+feast for price in [20, 18, 22]:
+   total = total + price
+beef
+total
+`, 60},
+		// Hash keys in insertion order
+		{`
+prep prices = {"brisket": 20, "ribs": 18}
+prep total = 0
+feast for cut in prices:
+   total = total + prices[cut]
+beef
+total
+`, 38},
+		// Plain 'for' without 'feast'
+		{`
+prep n = 0
+for x in [1, 2, 3]:
+   n = n * 10 + x
+beef
+n
+`, 123},
+		// Loop control
+		{`
+prep sum = 0
+feast for i in range(0, 100):
+   if i % 2 == 0:
+      repent
+   beef
+   if i > 7:
+      sacrifice
+   beef
+   sum = sum + i
+beef
+sum
+`, 16}, // 1 + 3 + 5 + 7
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		integer, ok := result.(*object.Integer)
+		assert.True(t, ok, "Result should be an Integer for input: %s, got %T (%v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, integer.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalForLoopOverString(t *testing.T) {
+	input := `
+prep reversed = ""
+feast for ch in "beef":
+   reversed = ch + reversed
+beef
+reversed
+`
+	result := testEval(input)
+
+	str, ok := result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T", result)
+	assert.Equal(t, "feeb", str.Value)
+}
+
+func TestForLoopVariableIsScopedToLoop(t *testing.T) {
+	input := `
+feast for i in range(0, 3):
+   i
+beef
+i
+`
+	result := testEval(input)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Loop variable should not leak, got %T", result)
+	assert.Contains(t, errObj.Message, "identifier not found: i")
+}
+
+func TestForLoopClosuresCaptureEachIteration(t *testing.T) {
+	// Every iteration has a fresh binding, so each closure sees its own value
+	input := `
+prep fns = []
+feast for i in range(0, 3):
+   praise get():
+      serve i
+   beef
+   fns = push(fns, get)
+beef
+fns[0]() + fns[1]() * 10 + fns[2]() * 100
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%v)", result, result)
+	assert.Equal(t, int64(210), integer.Value)
+}
+
+func TestForLoopReturnFromFunction(t *testing.T) {
+	input := `
+praise indexOf(items, target):
+   prep idx = 0
+   feast for item in items:
+      if item == target:
+         serve idx
+      beef
+      idx = idx + 1
+   beef
+   serve -1
+beef
+indexOf([5, 6, 7], 7) * 10 + indexOf([5], 9)
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T", result)
+	assert.Equal(t, int64(19), integer.Value)
+}
+
+func TestForLoopErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"feast for x in 5:\nbeef", "cannot iterate over INTEGER"},
+		{"feast for x in missing:\nbeef", "identifier not found: missing"},
+		{"feast for x in [1]:\n   x + true\nbeef", "type mismatch: INTEGER + BOOLEAN"},
+		{"range(0, 5, 0)", "range step cannot be zero"},
+		{`range("a")`, "arguments to range must be INTEGER, got STRING"},
+		{"range()", "wrong number of arguments to range: expected 1 to 3, got 0"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expectedMessage, errObj.Message, "Input: %s", tt.input)
+			assert.Greater(t, errObj.Line, 0, "Error should have line number")
+		}
+	}
+}
+
+func TestBuiltinRange(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"range(5)", "range(0, 5)"},
+		{"range(2, 5)", "range(2, 5)"},
+		{"range(5, 0, -1)", "range(5, 0, -1)"},
+		{"len(range(0, 10, 3))", "4"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+		assert.Equal(t, tt.expected, result.Inspect(), "Input: %s", tt.input)
+	}
+}
+
+func TestMemberAccessOnNonModuleError(t *testing.T) {
+	result := testEval("prep x = 5\nx.value")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "cannot access member value on INTEGER", errObj.Message)
+		assert.Equal(t, 2, errObj.Line)
+	}
+}
+
+func TestEvalTryCatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected interface{}
+	}{
+		{"body without error", `
+try:
+   40 + 2
+catch err:
+   0
+beef
+`, int64(42)},
+		{"handler runs on error", `
+prep status = "ok"
+try:
+   prep x = 1 + true
+   status = "not reached"
+catch err:
+   status = "caught"
+beef
+status
+`, "caught"},
+		{"error message", `
+try:
+   missing
+catch err:
+   err.message
+beef
+`, "identifier not found: missing"},
+		{"error location", `
+try:
+   prep x = 1
+   x = x + true
+catch err:
+   err.line * 100 + err.column
+beef
+`, int64(410)},
+		{"errors raised in called functions", `
+praise risky(n):
+   serve n / "two"
+beef
+try:
+   risky(4)
+catch problem:
+   problem.message
+beef
+`, "type mismatch: INTEGER / STRING"},
+		{"builtin errors", `
+try:
+   range(1, 2, 0)
+catch err:
+   err.line
+beef
+`, int64(3)},
+		{"serve passes through try", `
+praise f():
+   try:
+      serve 7
+   catch err:
+      serve 0
+   beef
+   serve 1
+beef
+f()
+`, int64(7)},
+		{"sacrifice passes through try", `
+prep count = 0
+feast for i in range(10):
+   try:
+      if i == 3:
+         sacrifice
+      beef
+      count = count + 1
+   catch err:
+      count = 100
+   beef
+beef
+count
+`, int64(3)},
+		{"caught error can be stored", `
+prep saved = 0
+try:
+   [1, 2][5]
+catch err:
+   saved = err
+beef
+saved.message
+`, "index out of bounds: index 5, length 2"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int64:
+			integer, ok := result.(*object.Integer)
+			assert.True(t, ok, "%s: result should be an Integer, got %T (%+v)", tt.name, result, result)
+			if ok {
+				assert.Equal(t, expected, integer.Value, tt.name)
+			}
+		case string:
+			str, ok := result.(*object.String)
+			assert.True(t, ok, "%s: result should be a String, got %T (%+v)", tt.name, result, result)
+			if ok {
+				assert.Equal(t, expected, str.Value, tt.name)
+			}
+		}
+	}
+}
+
+func TestTryCatchScopes(t *testing.T) {
+	tests := []string{
+		// prep in the body stays in the body
+		`
+try:
+   prep inner = 1
+catch err:
+   0
+beef
+inner
+`,
+		// The error variable only exists in the handler
+		`
+try:
+   1 + true
+catch inner:
+   0
+beef
+inner
+`,
+	}
+
+	for _, input := range tests {
+		result := testEval(input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T", input, result)
+		if ok {
+			assert.Equal(t, "identifier not found: inner", errObj.Message)
+		}
+	}
+}
+
+func TestSmiteRethrowsCaughtError(t *testing.T) {
+	input := `
+prep log = ""
+try:
+   try:
+      1 + true
+   catch err:
+      log = "inner"
+      smite err
+   beef
+catch err:
+   log = log + " outer " + err.message
+beef
+log
+`
+	result := testEval(input)
+
+	str, ok := result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "inner outer type mismatch: INTEGER + BOOLEAN", str.Value)
+	}
+
+	// An uncaught rethrow keeps the original location
+	result = testEval(`
+try:
+   prep x = 1
+   x + true
+catch err:
+   smite err
+beef
+`)
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	if ok {
+		assert.Equal(t, 4, errObj.Line)
+		assert.Equal(t, "type mismatch: INTEGER + BOOLEAN", errObj.Message)
+	}
+}
+
+func TestErrorValueMemberErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`
+try:
+   1 + true
+catch err:
+   err.stack
+beef
+`, "error has no member stack (available: cause, column, line, message)"},
+		{"smite 42", "cannot smite INTEGER: expected a STRING message or an error"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestSmiteMessage(t *testing.T) {
+	input := `
+praise withdraw(balance, amount):
+   if amount > balance:
+      smite "insufficient beef"
+   beef
+   serve balance - amount
+beef
+withdraw(5, 10)
+`
+	result := testEval(input)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "insufficient beef", errObj.Message)
+		assert.Equal(t, 4, errObj.Line)
+		assert.Equal(t, 7, errObj.Column)
+	}
+
+	// A smitten message can be caught like any other error
+	result = testEval(`
+try:
+   smite "bad cut"
+catch err:
+   err.message + " at line " + "2"
+beef
+`)
+	str, ok := result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "bad cut at line 2", str.Value)
+	}
+}
+
+func TestErrorsModule(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected interface{}
+	}{
+		{"new creates a value, not a failure", `
+wrangle errors
+prep e = errors.new("too rare")
+errors.message(e)
+`, "too rare"},
+		{"new is located at the call", `
+wrangle errors
+prep e = errors.new("too rare")
+e.line * 100 + e.column
+`, int64(320)},
+		{"is_error", `
+wrangle errors
+errors.is_error(errors.new("x")) and not errors.is_error("x")
+`, true},
+		{"wrap adds context and keeps the cause", `
+wrangle errors
+try:
+   1 + true
+catch err:
+   prep wrapped = errors.wrap(err, "while grilling")
+   wrapped.message + " / " + errors.cause(wrapped).message
+beef
+`, "while grilling: type mismatch: INTEGER + BOOLEAN / type mismatch: INTEGER + BOOLEAN"},
+		{"wrap keeps the original location", `
+wrangle errors
+try:
+   prep x = 1
+
+   x + true
+catch err:
+   errors.wrap(err, "context").line
+beef
+`, int64(6)},
+		{"unwrapped error has no cause", `
+wrangle errors
+errors.is_error(errors.cause(errors.new("plain")))
+`, false},
+		{"smite an error value keeps its location", `
+wrangle errors
+prep e = errors.new("stale")
+
+try:
+   smite e
+catch err:
+   err.line
+beef
+`, int64(3)},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int64:
+			integer, ok := result.(*object.Integer)
+			assert.True(t, ok, "%s: result should be an Integer, got %T (%+v)", tt.name, result, result)
+			if ok {
+				assert.Equal(t, expected, integer.Value, tt.name)
+			}
+		case string:
+			str, ok := result.(*object.String)
+			assert.True(t, ok, "%s: result should be a String, got %T (%+v)", tt.name, result, result)
+			if ok {
+				assert.Equal(t, expected, str.Value, tt.name)
+			}
+		case bool:
+			boolean, ok := result.(*object.Boolean)
+			assert.True(t, ok, "%s: result should be a Boolean, got %T (%+v)", tt.name, result, result)
+			if ok {
+				assert.Equal(t, expected, boolean.Value, tt.name)
+			}
+		}
+	}
+}
+
+func TestErrorsModuleArgumentErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"wrangle errors\nerrors.new(42)", "argument to errors.new must be STRING, got INTEGER"},
+		{"wrangle errors\nerrors.new()", "wrong number of arguments to errors.new: expected 1, got 0"},
+		{"wrangle errors\nerrors.wrap(\"x\", \"y\")", "first argument to errors.wrap must be ERROR_VALUE, got STRING"},
+		{"wrangle errors\nerrors.message(1)", "argument to errors.message must be ERROR_VALUE, got INTEGER"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T", tt.input, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, 2, errObj.Line, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalStructs(t *testing.T) {
+	steak := `
+congregation Steak(cut, weight):
+   praise describe(self):
+      serve self.cut + " (" + self.size() + ")"
+   beef
+
+   praise size(self):
+      if self.weight > 16:
+         serve "big"
+      beef
+      serve "modest"
+   beef
+
+   praise trim(self, amount):
+      self.weight = self.weight - amount
+      serve self
+   beef
+beef
+`
+	tests := []struct {
+		name     string
+		input    string
+		expected interface{}
+	}{
+		{"field access", `prep s = Steak("ribeye", 12)
+s.weight`, int64(12)},
+		{"field assignment", `prep s = Steak("ribeye", 12)
+s.weight = 14
+s.weight`, int64(14)},
+		{"method call", `Steak("porterhouse", 20).describe()`, "porterhouse (big)"},
+		{"method mutates the instance", `prep s = Steak("sirloin", 20)
+s.trim(5)
+s.describe()`, "sirloin (modest)"},
+		{"method chaining", `Steak("flank", 30).trim(4).trim(6).weight`, int64(20)},
+		{"bound method as a value", `prep s = Steak("rump", 10)
+prep f = s.trim
+f(3)
+s.weight`, int64(7)},
+		{"instances are independent", `prep a = Steak("a", 1)
+prep b = Steak("b", 2)
+a.weight = 100
+b.weight`, int64(2)},
+		{"instance shared by reference", `prep a = Steak("a", 1)
+prep b = a
+b.weight = 5
+a.weight`, int64(5)},
+		{"inspect", `prep s = Steak("ribeye", 12)
+s.trim(2).describe()
+Steak("t-bone", 3)`, "Steak(cut: t-bone, weight: 3)"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(steak + tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int64:
+			integer, ok := result.(*object.Integer)
+			assert.True(t, ok, "%s: result should be an Integer, got %T (%+v)", tt.name, result, result)
+			if ok {
+				assert.Equal(t, expected, integer.Value, tt.name)
+			}
+		case string:
+			assert.NotNil(t, result, tt.name)
+			if result != nil {
+				assert.Equal(t, expected, result.Inspect(), tt.name)
+			}
+		}
+	}
+}
+
+func TestEvalPlainRecord(t *testing.T) {
+	input := `
+congregation Point(x, y)
+prep points = [Point(1, 2), Point(3, 4)]
+prep total = 0
+feast for p in points:
+   total = total + p.x * p.y
+beef
+total
+`
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(14), integer.Value)
+	}
+}
+
+func TestStructErrors(t *testing.T) {
+	steak := "congregation Steak(cut, weight):\n   praise describe(self): serve self.cut beef\nbeef\n"
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{steak + `Steak("ribeye")`, "wrong number of arguments to Steak: expected 2, got 1"},
+		{steak + `Steak("ribeye", 1).color`, "Steak has no field or method color (available: cut, describe, weight)"},
+		{steak + "prep s = Steak(\"ribeye\", 1)\ns.color = \"red\"", "Steak has no field color (fields: cut, weight)"},
+		{steak + "prep s = Steak(\"ribeye\", 1)\ns.describe = 1", "Steak has no field describe (fields: cut, weight)"},
+		{"prep n = 5\nn.value = 1", "cannot assign to member value of INTEGER"},
+		{"congregation Bad(a, a)", "duplicate field a in congregation Bad"},
+		{"congregation Bad(a):\n   praise a(self): serve 1 beef\nbeef", "duplicate field or method a in congregation Bad"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestEvalInterpolatedString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"prep sum = 41\n\"total: {sum + 1}\"", "total: 42"},
+		{`"{1}{2}{3}"`, "123"},
+		{`"pi is about {3.14}"`, "pi is about 3.14"},
+		{`"cut: {"ribeye"}, rare: {true}"`, "cut: ribeye, rare: true"},
+		{`"items: {[1, "two"]}"`, "items: [1, two]"},
+		{`"outer {"inner {1 + 1}"}"`, "outer inner 2"},
+		{`"literal \{braces\} {len("beef")}"`, "literal {braces} 4"},
+		{"praise double(x): serve x * 2 beef\n\"\"\"{double(\n  21\n)}!\"\"\"", "42!"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		str, ok := result.(*object.String)
+		assert.True(t, ok, "Result should be a String for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, str.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestInterpolationErrorLocation(t *testing.T) {
+	input := "prep n = 1\nprep s = \"a {n}\n b {n + true}\""
+	result := testEval(input)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "type mismatch: INTEGER + BOOLEAN", errObj.Message)
+		assert.Equal(t, 3, errObj.Line)
+		assert.Equal(t, 7, errObj.Column)
+	}
+}
+
+func TestEvalTripleQuotedString(t *testing.T) {
+	input := `prep name = "Beefy"
+praise help():
+   serve """
+      Hello, {name}!
+        Indented "quote"
+      """
+beef
+help()`
+
+	result := testEval(input)
+
+	str, ok := result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "Hello, Beefy!\n  Indented \"quote\"", str.Value)
+	}
+
+	// A file saved with CRLF line endings lays out the same way
+	result = testEval(strings.ReplaceAll(input, "\n", "\r\n"))
+	str, ok = result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "Hello, Beefy!\r\n  Indented \"quote\"", str.Value)
+	}
+
+	// Errors inside a block string point at the embedded expression
+	result = testEval("prep s = \"\"\"\n   {\n     1 + true\n   }\n   \"\"\"")
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, 3, errObj.Line)
+		assert.Equal(t, 8, errObj.Column)
+	}
+}
+
+func TestFunctionArityErrors(t *testing.T) {
+	steak := "congregation Steak(cut):\n   praise describe(self, style = \"rare\"): serve style beef\nbeef\n"
+
+	tests := []struct {
+		input    string
+		expected string
+		column   int
+	}{
+		{"praise add(a, b): serve a + b beef\nadd(1)", "wrong number of arguments to add: expected 2, got 1", 4},
+		{"praise add(a, b): serve a + b beef\nadd(1, 2, 3)", "wrong number of arguments to add: expected 2, got 3", 4},
+		{"praise none(): serve 1 beef\nnone(1)", "wrong number of arguments to none: expected 0, got 1", 5},
+		{"prep f = praise(x): serve x beef\nf()", "wrong number of arguments to anonymous function: expected 1, got 0", 2},
+		{"praise greet(name, greeting = \"hi\"): serve name beef\ngreet()", "wrong number of arguments to greet: expected 1 to 2, got 0", 6},
+		{steak + "Steak(\"t\").describe(1, 2)", "wrong number of arguments to Steak.describe: expected 0 to 1, got 2", 20},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestDefaultParameters(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"praise greet(name, greeting = \"Braised be\"): serve \"{greeting} {name}\" beef\ngreet(\"Beefy\")", "Braised be Beefy"},
+		{"praise greet(name, greeting = \"Braised be\"): serve \"{greeting} {name}\" beef\ngreet(\"Beefy\", \"Hail\")", "Hail Beefy"},
+		// Defaults can use earlier parameters
+		{"praise span(start, end = start + 10): serve \"{start}-{end}\" beef\nspan(5)", "5-15"},
+		// Defaults are evaluated at call time, not when the function is declared
+		{"prep unit = \"g\"\npraise weigh(n, u = unit): serve \"{n}{u}\" beef\nunit = \"kg\"\nweigh(2)", "2kg"},
+		{"praise add(item, list = []): serve push(list, item) beef\nadd(1)\n\"{add(2)}\"", "[2]"},
+		{"congregation Steak(cut):\n   praise describe(self, style = \"rare\"): serve \"{self.cut} {style}\" beef\nbeef\nSteak(\"ribeye\").describe()", "ribeye rare"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		str, ok := result.(*object.String)
+		assert.True(t, ok, "Result should be a String for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, str.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestDefaultParameterErrors(t *testing.T) {
+	result := testEval("praise f(x = 1 + true): serve x beef\nf()")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "type mismatch: INTEGER + BOOLEAN", errObj.Message)
+		assert.Equal(t, 1, errObj.Line)
+		assert.Equal(t, 16, errObj.Column)
+	}
+}
+
+func TestRestParameters(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"praise log(level, ...parts): serve \"{level} {parts}\" beef\nlog(\"info\")", "info []"},
+		{"praise log(level, ...parts): serve \"{level} {parts}\" beef\nlog(\"warn\", 1, \"two\", [3])", "warn [1, two, [3]]"},
+		{"praise count(...all): serve \"{len(all)}\" beef\ncount(1, 2, 3)", "3"},
+		{"praise f(a, b = 2, ...rest): serve \"{a} {b} {rest}\" beef\nf(1)", "1 2 []"},
+		{"praise f(a, b = 2, ...rest): serve \"{a} {b} {rest}\" beef\nf(1, 3, 4, 5)", "1 3 [4, 5]"},
+		{"congregation Pit(name):\n   praise smoke(self, ...cuts): serve \"{self.name}: {cuts}\" beef\nbeef\nPit(\"big\").smoke(\"brisket\", \"ribs\")", "big: [brisket, ribs]"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		str, ok := result.(*object.String)
+		assert.True(t, ok, "Result should be a String for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, str.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestKeywordArguments(t *testing.T) {
+	connect := "praise connect(host = \"localhost\", port = 80, secure = false): serve \"{host}:{port} {secure}\" beef\n"
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{connect + "connect(port = 1, host = \"x\")", "x:1 false"},
+		{connect + "connect(\"db\", secure = true)", "db:80 true"},
+		{connect + "connect()", "localhost:80 false"},
+		// Defaults can use parameters given by keyword
+		{"praise span(start, end = start + 10): serve \"{start}-{end}\" beef\nspan(start = 5)", "5-15"},
+		{"praise f(a, ...rest): serve \"{a} {rest}\" beef\nf(a = 1)", "1 []"},
+		{"congregation Steak(cut):\n   praise describe(self, style = \"rare\"): serve \"{self.cut} {style}\" beef\nbeef\nSteak(\"t\").describe(style = \"blue\")", "t blue"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		str, ok := result.(*object.String)
+		assert.True(t, ok, "Result should be a String for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, str.Value, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestCallArgumentErrors(t *testing.T) {
+	connect := "praise connect(host, port = 80): serve host beef\n"
+
+	tests := []struct {
+		input    string
+		expected string
+		column   int
+	}{
+		{connect + "connect(hots = \"x\")", "connect has no parameter named hots (parameters: host, port)", 9},
+		{connect + "connect(\"a\", host = \"b\")", "connect got multiple values for parameter host", 14},
+		{connect + "connect(port = 1)", "missing argument for parameter host in call to connect", 8},
+		{"praise log(level, ...parts): serve level beef\nlog()", "wrong number of arguments to log: expected at least 1, got 0", 4},
+		{"len(x = [1])", "builtin functions do not take keyword arguments", 5},
+		{"congregation Steak(cut)\nSteak(cut = 1)", "Steak does not take keyword arguments", 7},
+		{"congregation Steak(cut):\n   praise m(self, a): serve a beef\nbeef\nSteak(1).m(self = 2)", "Steak.m got multiple values for parameter self", 12},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestConditionErrorsPropagate(t *testing.T) {
+	tests := []struct {
+		input  string
+		line   int
+		column int
+	}{
+		{"if 1 + true:\n   1\nbeef", 1, 6},
+		{"if false:\n   1\nelse if 1 + true:\n   2\nbeef", 3, 11},
+		{"feast while 1 + true:\n   1\nbeef", 1, 15},
+		{"prep i = 0\nfeast while i < 3:\n   i = i + 1\n   prep x = i + true\nbeef", 4, 15},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.line, errObj.Line, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestWhileBodyErrorStopsLoop(t *testing.T) {
+	input := `
+prep i = 0
+try:
+   feast while i < 5:
+      i = i + 1
+      prep x = i + true
+   beef
+catch err:
+   prep caught = true
+beef
+i`
+
+	result := testEval(input)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(1), integer.Value, "the loop should stop at the first error")
+	}
+}
+
+func TestArgumentErrorsStopEvaluation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"praise f(a, b): serve 5 beef\nf(1 + true, 2)", "type mismatch: INTEGER + BOOLEAN"},
+		{"praise f(a, b): serve 5 beef\nf(1, 1 + true)", "type mismatch: INTEGER + BOOLEAN"},
+		{"[1, 2, -true]", "unknown operator: -BOOLEAN"},
+		{"len([1], \"a\" - 1)", "type mismatch: STRING - INTEGER"},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestServedErrorIsCatchable(t *testing.T) {
+	input := `
+praise risky():
+   try:
+      serve 1 / 0
+   catch err:
+      serve "caught: " + err.message
+   beef
+beef
+risky()`
+
+	result := testEval(input)
+
+	str, ok := result.(*object.String)
+	assert.True(t, ok, "Result should be a String, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "caught: division by zero", str.Value)
+	}
+}
+
+func TestErrorStackTrace(t *testing.T) {
+	input := `
+praise divide(a, b):
+   serve a / b
+beef
+praise countdown(n):
+   if n == 0:
+      serve divide(1, n)
+   beef
+   serve countdown(n - 1)
+beef
+countdown(2)`
+
+	result := testEval(input)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "division by zero", errObj.Message)
+		assert.Equal(t, []object.Frame{
+			// Where the error happened, inside divide
+			{Function: "divide", Line: 3, Column: 12},
+			// Each caller at its call to the frame above
+			{Function: "countdown", Line: 7, Column: 19},
+			{Function: "countdown", Line: 9, Column: 19},
+			{Function: "countdown", Line: 9, Column: 19},
+		}, errObj.Stack)
+	}
+}
+
+func TestErrorStackTraceOfBuiltinAndMethodCalls(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []object.Frame
+	}{
+		// Builtin errors are in the function that called the builtin
+		{"praise f(): serve len(1) beef\nf()", []object.Frame{{Function: "f", Line: 1, Column: 22}}},
+		{"congregation Steak(cut):\n   praise grill(self): serve self.cut + true beef\nbeef\nprep s = Steak(1)\ns.grill()",
+			[]object.Frame{{Function: "Steak.grill", Line: 2, Column: 39}}},
+		// Errors outside any function have no stack
+		{"1 / 0", nil},
+	}
+
+	for _, tt := range tests {
+		result := testEval(tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Stack, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestRethrownErrorKeepsStackTrace(t *testing.T) {
+	input := `
+praise risky():
+   serve 1 / 0
+beef
+try:
+   risky()
+catch err:
+   smite err
+beef`
+
+	result := testEval(input)
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, []object.Frame{{Function: "risky", Line: 3, Column: 12}}, errObj.Stack)
+	}
+
+	// An error caught in one function and raised again by another keeps the
+	// trace from where it was first raised
+	result = testEval(`
+praise fragile():
+   try:
+      prep x = 1 + true
+   catch err:
+      serve err
+   beef
+beef
+praise caller():
+   smite fragile()
+beef
+caller()`)
+
+	errObj, ok = result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, []object.Frame{
+			{Function: "fragile", Line: 4, Column: 18},
+			{Function: "caller", Line: 10, Column: 17},
+		}, errObj.Stack)
+	}
+}
+
+func TestCallEntryPoint(t *testing.T) {
+	env := NewEnvironment()
+	Eval(parser.New(lexer.New(`
+praise half(n):
+   serve n / 0
+beef
+praise ChurchOfBeef(extra = 1):
+   half(extra)
+beef`)).ParseProgram(), env)
+
+	entry, _ := env.Get("ChurchOfBeef")
+	result := CallEntryPoint(entry.(*object.Function))
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, []object.Frame{
+			{Function: "half", Line: 3, Column: 12},
+			{Function: "ChurchOfBeef", Line: 6, Column: 8},
+		}, errObj.Stack)
+	}
+
+	// The entry point takes no arguments
+	Eval(parser.New(lexer.New("praise ChurchOfBeef(n): serve n beef")).ParseProgram(), env)
+	entry, _ = env.Get("ChurchOfBeef")
+	result = CallEntryPoint(entry.(*object.Function))
+
+	errObj, ok = result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "wrong number of arguments to ChurchOfBeef: expected 1, got 0", errObj.Message)
+	}
+}
+
+func TestMaximumRecursionDepth(t *testing.T) {
+	result := testEval("praise r(n): serve r(n + 1) beef\nr(0)")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "maximum recursion depth exceeded: more than 10000 nested calls", errObj.Message)
+		assert.Equal(t, 1, errObj.Line)
+		assert.Equal(t, 21, errObj.Column)
+		assert.Len(t, errObj.Stack, maxCallDepth)
+	}
+
+	// The error can be caught, and recursion within the limit still works
+	result = testEval(`
+praise count(n):
+   if n == 0:
+      serve 0
+   beef
+   serve 1 + count(n - 1)
+beef
+prep deep = count(9000)
+try:
+   count(20000)
+catch err:
+   deep = deep + 1
+beef
+deep`)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(9001), integer.Value)
+	}
+}
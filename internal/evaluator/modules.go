@@ -0,0 +1,277 @@
+package evaluator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/elitwilson/beeflang/internal/ast"
+	"github.com/elitwilson/beeflang/internal/lexer"
+	"github.com/elitwilson/beeflang/internal/object"
+	"github.com/elitwilson/beeflang/internal/parser"
+	"github.com/elitwilson/beeflang/internal/token"
+)
+
+// ModuleExtension is the file extension of Beeflang source files.
+// wrangle utils looks for a file named utils.beef.
+const ModuleExtension = ".beef"
+
+// SearchPaths lists extra directories to look in for module files, after the
+// directory of the importing file. main.go fills it from BEEFPATH.
+var SearchPaths []string
+
+// builtinModules are the modules implemented in Go, available everywhere.
+var builtinModules = map[string]func() *object.Module{
+	"io":     createIOModule,
+	"errors": createErrorsModule,
+}
+
+// moduleCache holds every file module loaded so far, keyed by absolute path,
+// so a module is evaluated once no matter how many files wrangle it.
+var moduleCache = map[string]*object.Module{}
+
+// loadingModules is the chain of files currently being evaluated, starting
+// with the file whose wrangle began the chain (usually the program), used to
+// detect import cycles (a wrangles b, b wrangles a).
+var loadingModules []string
+
+// loadModule returns the module called name, wrangled from the file importer.
+// Built-in modules like io take precedence over files. The result is either a
+// *object.Module or an *object.Error located at tok.
+func loadModule(tok token.Token, name string, importer string) object.Object {
+	if create, ok := builtinModules[name]; ok {
+		return create()
+	}
+
+	path, ok := findModuleFile(name, importer)
+	if !ok {
+		return newError(tok, "unknown module: %s (available: %s)",
+			name, strings.Join(availableModules(importer), ", "))
+	}
+
+	// The file that starts a chain of wrangles is part of it, so a module
+	// wrangling it back is a cycle rather than a second run of that file
+	if len(loadingModules) == 0 && importer != "" {
+		if absImporter, err := filepath.Abs(importer); err == nil {
+			loadingModules = []string{absImporter}
+			defer func() { loadingModules = nil }()
+		}
+	}
+
+	return loadModuleFile(tok, name, path)
+}
+
+// moduleDirs lists the directories searched for module files, in order:
+// the importing file's directory (or the working directory), then SearchPaths.
+func moduleDirs(importer string) []string {
+	dirs := []string{"."}
+	if importer != "" {
+		dirs[0] = filepath.Dir(importer)
+	}
+	return append(dirs, SearchPaths...)
+}
+
+// findModuleFile looks for name.beef in each of the module directories.
+func findModuleFile(name string, importer string) (string, bool) {
+	for _, dir := range moduleDirs(importer) {
+		candidate := filepath.Join(dir, name+ModuleExtension)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+// loadModuleFile evaluates a module file in its own global environment and
+// collects its exports. The module is cached on success.
+func loadModuleFile(tok token.Token, name string, path string) object.Object {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return newError(tok, "cannot load module %s: %s", name, err)
+	}
+
+	if mod, ok := moduleCache[absPath]; ok {
+		return mod
+	}
+
+	for i, loading := range loadingModules {
+		if loading == absPath {
+			return newError(tok, "import cycle: %s", describeCycle(loadingModules[i:], absPath))
+		}
+	}
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return newError(tok, "cannot load module %s: %s", name, err)
+	}
+
+	p := parser.New(lexer.New(string(source)))
+	program := p.ParseProgram()
+	if len(p.Errors()) > 0 {
+		return newError(tok, "cannot load module %s (%s): %s", name, path, strings.Join(p.Errors(), "; "))
+	}
+
+	modEnv := NewEnvironment()
+	modEnv.SetFile(path)
+
+	loadingModules = append(loadingModules, absPath)
+	result := Eval(program, modEnv)
+	loadingModules = loadingModules[:len(loadingModules)-1]
+
+	if errObj, ok := result.(*object.Error); ok {
+		// Errors raised by the module's own code are located in its file
+		if errObj.File == "" && errObj.Line > 0 {
+			errObj.File = path
+		}
+		return errObj
+	}
+
+	members, errObj := moduleExports(program, modEnv, name, path)
+	if errObj != nil {
+		return errObj
+	}
+
+	mod := &object.Module{Name: name, Members: members}
+	moduleCache[absPath] = mod
+	return mod
+}
+
+// moduleExports decides which of a module's top-level bindings become members.
+// A herd statement with an export list limits them to the listed names;
+// otherwise everything the file declares is exported, except modules it
+// wrangled itself.
+func moduleExports(program *ast.Program, modEnv *Environment, name string, path string) (map[string]object.Object, *object.Error) {
+	var herd *ast.HerdStatement
+	for _, stmt := range program.Statements {
+		if h, ok := stmt.(*ast.HerdStatement); ok {
+			herd = h
+			break
+		}
+	}
+
+	members := make(map[string]object.Object)
+
+	if herd != nil && herd.Name.Value != name {
+		errObj := newError(herd.Name.Token, "module file declares herd %s, but was wrangled as %s", herd.Name.Value, name)
+		errObj.File = path
+		return nil, errObj
+	}
+
+	if herd != nil && len(herd.Exports) > 0 {
+		for _, export := range herd.Exports {
+			val, ok := modEnv.Get(export.Value)
+			if !ok {
+				errObj := newError(export.Token, "herd %s exports undefined name: %s", name, export.Value)
+				errObj.File = path
+				return nil, errObj
+			}
+			members[export.Value] = val
+		}
+		return members, nil
+	}
+
+	for binding, val := range modEnv.Bindings() {
+		if _, isModule := val.(*object.Module); isModule {
+			continue
+		}
+		members[binding] = val
+	}
+	return members, nil
+}
+
+// availableModules lists, sorted, the built-in modules and the module files
+// that a wrangle from importer could load. Files that would lead back to the
+// importer or to a file still being loaded are left out, since wrangling them
+// is an import cycle. Used to suggest fixes for typos.
+func availableModules(importer string) []string {
+	inChain := make(map[string]bool)
+	for _, loading := range loadingModules {
+		inChain[loading] = true
+	}
+	if absImporter, err := filepath.Abs(importer); importer != "" && err == nil {
+		inChain[absImporter] = true
+	}
+
+	available := make(map[string]bool)
+	for name := range builtinModules {
+		available[name] = true
+	}
+
+	checked := make(map[string]bool)
+	for _, dir := range moduleDirs(importer) {
+		matches, _ := filepath.Glob(filepath.Join(dir, "*"+ModuleExtension))
+		for _, match := range matches {
+			name := moduleNameFromPath(match)
+			if checked[name] {
+				continue
+			}
+			checked[name] = true
+
+			// A wrangle loads the first file with the name, which may be in
+			// an earlier directory than this match
+			path, ok := findModuleFile(name, importer)
+			if ok && !wranglesBack(path, inChain, make(map[string]bool)) {
+				available[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(available))
+	for name := range available {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// wranglesBack reports whether loading the module file at path would reach a
+// file in chain, either because it is one or through the wrangles at the top
+// of it and of the modules it loads in turn.
+func wranglesBack(path string, chain map[string]bool, visited map[string]bool) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil || chain[absPath] {
+		return true
+	}
+	// A cached module is not evaluated again, and a visited one is already
+	// being checked further up
+	if _, cached := moduleCache[absPath]; cached || visited[absPath] {
+		return false
+	}
+	visited[absPath] = true
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	program := parser.New(lexer.New(string(source))).ParseProgram()
+	for _, stmt := range program.Statements {
+		wrangle, ok := stmt.(*ast.WrangleStatement)
+		if !ok {
+			continue
+		}
+		if _, builtin := builtinModules[wrangle.ModuleName.Value]; builtin {
+			continue
+		}
+		next, ok := findModuleFile(wrangle.ModuleName.Value, path)
+		if ok && wranglesBack(next, chain, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeCycle renders a cycle as module names: a -> b -> a
+func describeCycle(chain []string, repeated string) string {
+	names := make([]string, 0, len(chain)+1)
+	for _, path := range chain {
+		names = append(names, moduleNameFromPath(path))
+	}
+	names = append(names, moduleNameFromPath(repeated))
+	return strings.Join(names, " -> ")
+}
+
+func moduleNameFromPath(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), ModuleExtension)
+}
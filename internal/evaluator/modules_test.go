@@ -0,0 +1,402 @@
+package evaluator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/elitwilson/beeflang/internal/lexer"
+	"github.com/elitwilson/beeflang/internal/object"
+	"github.com/elitwilson/beeflang/internal/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeModules creates the given files in a fresh directory and returns it
+func writeModules(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, source := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(source), 0o644))
+	}
+	return dir
+}
+
+// testEvalFile evaluates source as if it were the file at path
+func testEvalFile(t *testing.T, path string, source string) object.Object {
+	t.Helper()
+	p := parser.New(lexer.New(source))
+	program := p.ParseProgram()
+	assert.Empty(t, p.Errors())
+
+	env := NewEnvironment()
+	env.SetFile(path)
+	return Eval(program, env)
+}
+
+func TestWrangleFileModule(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"utils.beef": `
+pack FACTOR = 3
+praise scale(x):
+   serve x * FACTOR
+beef
+`,
+	})
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), `
+wrangle utils
+utils.scale(utils.FACTOR)
+`)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(9), integer.Value)
+	}
+}
+
+func TestWrangleModuleFunctionsKeepModuleScope(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"counter.beef": `
+prep count = 0
+praise bump():
+   count = count + 1
+   serve count
+beef
+`,
+	})
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), `
+wrangle counter
+prep count = 100
+counter.bump()
+counter.bump()
+`)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(2), integer.Value, "bump should update the module's own count")
+	}
+}
+
+func TestHerdExportList(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"shapes.beef": `
+herd shapes(area)
+praise square(x):
+   serve x * x
+beef
+praise area(side):
+   serve square(side)
+beef
+`,
+	})
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), "wrangle shapes\nshapes")
+
+	mod, ok := result.(*object.Module)
+	assert.True(t, ok, "Result should be a Module, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "shapes", mod.Name)
+		_, hasArea := mod.Get("area")
+		assert.True(t, hasArea, "area is exported")
+		_, hasSquare := mod.Get("square")
+		assert.False(t, hasSquare, "square is not in the export list")
+	}
+}
+
+func TestModuleDoesNotExportWrangledModules(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"greet.beef": `
+wrangle io
+prep greeting = "moo"
+`,
+	})
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), "wrangle greet\ngreet")
+
+	mod, ok := result.(*object.Module)
+	assert.True(t, ok, "Result should be a Module, got %T (%+v)", result, result)
+	if ok {
+		_, hasIO := mod.Get("io")
+		assert.False(t, hasIO)
+		_, hasGreeting := mod.Get("greeting")
+		assert.True(t, hasGreeting)
+	}
+}
+
+func TestWrangleFromSearchPath(t *testing.T) {
+	libDir := writeModules(t, map[string]string{
+		"lib.beef": "prep answer = 42",
+	})
+	mainDir := t.TempDir()
+
+	oldPaths := SearchPaths
+	SearchPaths = []string{libDir}
+	defer func() { SearchPaths = oldPaths }()
+
+	result := testEvalFile(t, filepath.Join(mainDir, "main.beef"), "wrangle lib\nlib.answer")
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(42), integer.Value)
+	}
+}
+
+func TestWrangleResolvesRelativeToImportingModule(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"lib/outer.beef": `
+wrangle inner
+prep value = inner.value + 1
+`,
+		"lib/inner.beef": "prep value = 41",
+	})
+
+	oldPaths := SearchPaths
+	SearchPaths = []string{filepath.Join(dir, "lib")}
+	defer func() { SearchPaths = oldPaths }()
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), "wrangle outer\nouter.value")
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(42), integer.Value)
+	}
+}
+
+func TestModulesAreEvaluatedOnce(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"state.beef": "prep items = {}",
+		"a.beef": `
+wrangle state
+state.items["a"] = true
+`,
+		"b.beef": `
+wrangle state
+state.items["b"] = true
+`,
+	})
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), `
+wrangle a
+wrangle b
+wrangle state
+len(state.items)
+`)
+
+	integer, ok := result.(*object.Integer)
+	assert.True(t, ok, "Result should be an Integer, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, int64(2), integer.Value, "a and b should share one state module")
+	}
+}
+
+func TestModuleLoadErrors(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"ping.beef":     "wrangle pong",
+		"pong.beef":     "wrangle ping",
+		"named.beef":    "herd other",
+		"exports.beef":  "herd exports(missing)",
+		"broken.beef":   "prep = 5",
+		"failing.beef":  "prep x = 1 + true",
+		"selfish.beef":  "wrangle selfish",
+		"harmless.beef": "prep ok = true",
+		"backward.beef": "wrangle main",
+		"main.beef":     "wrangle backward",
+	})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"wrangle ping", "import cycle: ping -> pong -> ping"},
+		{"wrangle selfish", "import cycle: selfish -> selfish"},
+		// The program that started the chain is part of it
+		{"wrangle backward", "import cycle: main -> backward -> main"},
+		{"wrangle named", "module file declares herd other, but was wrangled as named"},
+		{"wrangle exports", "herd exports exports undefined name: missing"},
+		{"wrangle broken", "cannot load module broken"},
+		{"wrangle failing", "type mismatch"},
+	}
+
+	for _, tt := range tests {
+		result := testEvalFile(t, filepath.Join(dir, "main.beef"), tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Contains(t, errObj.Message, tt.expected, "Input: %s", tt.input)
+		}
+	}
+}
+
+func TestModuleErrorsAreLocatedInModuleFile(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"bad.beef": "prep a = 1\nprep b = a + true",
+	})
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), "wrangle bad")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	if ok {
+		assert.Equal(t, filepath.Join(dir, "bad.beef"), errObj.File)
+		assert.Equal(t, 2, errObj.Line)
+	}
+}
+
+func TestModuleFunctionErrorsAreLocatedInModuleFile(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"utils.beef": "praise boom():\n   serve 1 / 0\nbeef\npraise apply(f):\n   serve f()\nbeef",
+	})
+	mainPath := filepath.Join(dir, "main.beef")
+
+	tests := []struct {
+		source string
+		file   string
+		line   int
+	}{
+		// Raised inside the module function
+		{"wrangle utils\nutils.boom()", filepath.Join(dir, "utils.beef"), 2},
+		// Raised by a function from the program that the module calls back
+		{"wrangle utils\npraise bad(): serve 1 + true beef\nutils.apply(bad)", mainPath, 2},
+		// Raised in the module because of a bad argument from the program
+		{"wrangle utils\nutils.apply(1)", filepath.Join(dir, "utils.beef"), 5},
+	}
+
+	for _, tt := range tests {
+		result := testEvalFile(t, mainPath, tt.source)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for source: %s, got %T", tt.source, result)
+		if ok {
+			assert.Equal(t, tt.file, errObj.File, "Source: %s", tt.source)
+			assert.Equal(t, tt.line, errObj.Line, "Source: %s", tt.source)
+		}
+	}
+}
+
+func TestStackTraceFramesNameTheirFile(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"math.beef": "praise half(n):\n   serve n / 0\nbeef\npraise quarter(n):\n   serve half(half(n))\nbeef",
+	})
+	mainPath := filepath.Join(dir, "main.beef")
+
+	result := testEvalFile(t, mainPath, "wrangle math\npraise run(): serve math.quarter(8) beef\nrun()")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	if ok {
+		assert.Equal(t, []object.Frame{
+			{Function: "half", Line: 2, Column: 12, File: filepath.Join(dir, "math.beef")},
+			{Function: "quarter", Line: 5, Column: 19, File: filepath.Join(dir, "math.beef")},
+			{Function: "run", Line: 2, Column: 33, File: mainPath},
+		}, errObj.Stack)
+	}
+}
+
+func TestStackTraceOfModuleLoadErrorStopsAtWrangle(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"bad.beef": "prep a = 1\nprep b = a + true",
+	})
+	mainPath := filepath.Join(dir, "main.beef")
+
+	result := testEvalFile(t, mainPath, "praise load():\n   wrangle bad\nbeef\nload()")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	if ok {
+		assert.Equal(t, 2, errObj.Line)
+		assert.Equal(t, []object.Frame{{Function: "load", Line: 2, Column: 12, File: mainPath}}, errObj.Stack)
+	}
+}
+
+func TestUnknownModuleError(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"utils.beef":     "prep x = 1",
+		"lib/extra.beef": "prep y = 2",
+		"notes/todo.txt": "not a module",
+	})
+
+	oldPaths := SearchPaths
+	SearchPaths = []string{filepath.Join(dir, "lib")}
+	defer func() { SearchPaths = oldPaths }()
+
+	result := testEvalFile(t, filepath.Join(dir, "main.beef"), "\nwrangle utilz")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "unknown module: utilz (available: errors, extra, io, utils)", errObj.Message)
+		assert.Equal(t, 2, errObj.Line)
+		assert.Equal(t, 9, errObj.Column)
+	}
+}
+
+func TestUnknownModuleSuggestionsLeaveOutImportCycles(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.beef":   "wrangle typo",
+		"typo.beef":   "wrangle utilz",
+		"utils.beef":  "prep x = 1",
+		"loop.beef":   "wrangle utils\nwrangle around",
+		"around.beef": "wrangle typo",
+	})
+	mainPath := filepath.Join(dir, "main.beef")
+
+	// Neither the program that wrangled typo, typo itself, nor the modules
+	// that wrangle typo in turn can be wrangled from typo without a cycle
+	result := testEvalFile(t, mainPath, "wrangle typo")
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "unknown module: utilz (available: errors, io, utils)", errObj.Message)
+	}
+
+	// Run directly, typo is still wrangled back by main, loop and around
+	result = testEvalFile(t, filepath.Join(dir, "typo.beef"), "wrangle utilz")
+
+	errObj, ok = result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T (%+v)", result, result)
+	if ok {
+		assert.Equal(t, "unknown module: utilz (available: errors, io, utils)", errObj.Message)
+	}
+}
+
+func TestMissingModuleMemberError(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"utils.beef": `
+praise double(x):
+   serve x * 2
+beef
+prep answer = 42
+`,
+	})
+
+	tests := []struct {
+		input    string
+		expected string
+		line     int
+		column   int
+	}{
+		{"wrangle io\nio.preahc(1)", "module io has no member preahc (available: input, preach)", 2, 4},
+		{"wrangle utils\nprep y = utils.tripple(3)", "module utils has no member tripple (available: answer, double)", 2, 16},
+	}
+
+	for _, tt := range tests {
+		result := testEvalFile(t, filepath.Join(dir, "main.beef"), tt.input)
+
+		errObj, ok := result.(*object.Error)
+		assert.True(t, ok, "Expected error object for input: %s, got %T (%+v)", tt.input, result, result)
+		if ok {
+			assert.Equal(t, tt.expected, errObj.Message, "Input: %s", tt.input)
+			assert.Equal(t, tt.line, errObj.Line, "Input: %s", tt.input)
+			assert.Equal(t, tt.column, errObj.Column, "Input: %s", tt.input)
+		}
+	}
+}
@@ -1,6 +1,14 @@
 package lexer
 
-import "github.com/elitwilson/beeflang/internal/token"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/elitwilson/beeflang/internal/token"
+)
 
 // Lexer performs lexical analysis (tokenization) on source code.
 // Lexical analysis is the first phase of an interpreter/compiler - it reads
@@ -13,6 +21,9 @@ import "github.com/elitwilson/beeflang/internal/token"
 // - position: points to the current character being examined
 // - readPosition: points to the next character (lookahead for multi-char tokens like "==")
 //
+// Source is read as UTF-8, one rune at a time. Both pointers are byte offsets,
+// but columns count characters, so "é" or "🥩" advance the column by one.
+//
 // Position tracking (line/column) is maintained throughout for error reporting.
 // When we encounter a syntax error later, we can say "error at line 5, column 12"
 // instead of just "syntax error somewhere".
@@ -20,7 +31,7 @@ type Lexer struct {
 	input        string // the entire source code as a string
 	position     int    // current position in input (current char)
 	readPosition int    // next reading position (lookahead position)
-	ch           byte   // current character under examination
+	ch           rune   // current character under examination
 	line         int    // current line number (starts at 1)
 	column       int    // current column number (starts at 1)
 }
@@ -36,6 +47,19 @@ func New(input string) *Lexer {
 	return l
 }
 
+// NewAt creates a Lexer for a fragment of a larger source whose first
+// character is at the given line and column, so token positions match the
+// original file. Used to lex the expressions embedded in interpolated strings.
+func NewAt(input string, line, column int) *Lexer {
+	l := &Lexer{
+		input:  input,
+		line:   line,
+		column: column - 1,
+	}
+	l.readChar()
+	return l
+}
+
 // NextToken reads the next token from the input and returns it
 func (l *Lexer) NextToken() token.Token {
 	var tok token.Token
@@ -114,10 +138,41 @@ func (l *Lexer) NextToken() token.Token {
 	case ',':
 		tok = l.newToken(token.COMMA, l.ch)
 	case '.':
-		tok = l.newToken(token.DOT, l.ch)
+		if l.peekChar() == '.' && l.peekSecondChar() == '.' {
+			l.readChar()
+			l.readChar()
+			tok = token.Token{Type: token.ELLIPSIS, Literal: "...", Line: tok.Line, Column: tok.Column}
+		} else {
+			tok = l.newToken(token.DOT, l.ch)
+		}
+	case '[':
+		tok = l.newToken(token.LBRACKET, l.ch)
+	case ']':
+		tok = l.newToken(token.RBRACKET, l.ch)
+	case '{':
+		tok = l.newToken(token.LBRACE, l.ch)
+	case '}':
+		tok = l.newToken(token.RBRACE, l.ch)
 	case '"':
+		start := l.position
+		interpolated, err := l.readString(l.isTripleQuote())
+		if err != nil {
+			// The literal of an ILLEGAL string token describes what went wrong
+			return token.Token{Type: token.ILLEGAL, Literal: err.message, Line: err.line, Column: err.column}
+		}
+		source := l.input[start:l.position]
+		if interpolated {
+			// The parser splits the source with SplitInterpolation and parses the embedded expressions
+			tok.Type = token.INTERPOLATED
+			tok.Literal = source
+			return tok
+		}
+		// Without interpolations the literal decodes to at most one text segment
 		tok.Type = token.STRING
-		tok.Literal = l.readString()
+		tok.Literal = ""
+		if segments := SplitInterpolation(source, tok.Line, tok.Column); len(segments) > 0 {
+			tok.Literal = segments[0].Text
+		}
 		return tok // Early return
 	case '#':
 		l.skipComment()
@@ -131,9 +186,11 @@ func (l *Lexer) NextToken() token.Token {
 			tok.Type = token.LookupIdent(tok.Literal)
 			return tok // Early return - readIdentifier already advanced
 		} else if isDigit(l.ch) {
-			tok.Type = token.INT
-			tok.Literal = l.readNumber()
+			tok.Literal, tok.Type = l.readNumber()
 			return tok // Early return - readNumber already advanced
+		} else if l.ch == utf8.RuneError {
+			tok = l.newToken(token.ILLEGAL, l.ch)
+			tok.Literal = "invalid UTF-8 encoding"
 		} else {
 			tok = l.newToken(token.ILLEGAL, l.ch)
 		}
@@ -145,47 +202,72 @@ func (l *Lexer) NextToken() token.Token {
 
 // readChar advances the lexer position and reads the next character
 func (l *Lexer) readChar() {
+	// Track newlines for line counting: the character after a newline
+	// starts the next line at column 1
+	if l.ch == '\n' {
+		l.line++
+		l.column = 0
+	}
+
+	l.position = l.readPosition
 	if l.readPosition >= len(l.input) {
 		l.ch = 0 // ASCII NUL - signals EOF
 	} else {
-		l.ch = l.input[l.readPosition]
+		var width int
+		l.ch, width = utf8.DecodeRuneInString(l.input[l.readPosition:])
+		l.readPosition += width
 	}
-	l.position = l.readPosition
-	l.readPosition++
 	l.column++
-
-	// Track newlines for line counting
-	if l.ch == '\n' {
-		l.line++
-		l.column = 0
-	}
 }
 
 // peekChar looks ahead at the next character without advancing position
-func (l *Lexer) peekChar() byte {
+func (l *Lexer) peekChar() rune {
 	if l.readPosition >= len(l.input) {
 		return 0
 	}
-	return l.input[l.readPosition]
+	ch, _ := utf8.DecodeRuneInString(l.input[l.readPosition:])
+	return ch
+}
+
+// peekSecondChar looks two characters ahead; only used to spot ASCII
+// sequences like """ and ..., so it checks a single byte
+func (l *Lexer) peekSecondChar() rune {
+	if l.readPosition+1 >= len(l.input) {
+		return 0
+	}
+	return rune(l.input[l.readPosition+1])
 }
 
 // readIdentifier reads an identifier or keyword (letters, underscores, and digits)
-// Identifiers must start with a letter or underscore, but can contain digits after that
+// Identifiers must start with a letter or underscore, but can contain digits after that.
+// Any unicode letter counts, along with combining marks (the accent in a decomposed "é").
 func (l *Lexer) readIdentifier() string {
 	position := l.position
-	for isLetter(l.ch) || isDigit(l.ch) {
+	for isLetter(l.ch) || isDigit(l.ch) || unicode.IsMark(l.ch) {
 		l.readChar()
 	}
 	return l.input[position:l.position]
 }
 
-// readNumber reads an integer literal
-func (l *Lexer) readNumber() string {
+// readNumber reads an integer literal (42) or a float literal (3.14)
+// A '.' only belongs to the number when a digit follows it, so "3." and
+// "x.y" style member access are never swallowed into a number
+func (l *Lexer) readNumber() (string, token.TokenType) {
 	position := l.position
+	tokenType := token.INT
 	for isDigit(l.ch) {
 		l.readChar()
 	}
-	return l.input[position:l.position]
+
+	if l.ch == '.' && isDigit(l.peekChar()) {
+		tokenType = token.FLOAT
+		l.readChar() // consume the '.'
+		for isDigit(l.ch) {
+			l.readChar()
+		}
+	}
+
+	return l.input[position:l.position], tokenType
 }
 
 // skipWhitespace skips over whitespace characters (space, tab, newline, carriage return)
@@ -202,29 +284,330 @@ func (l *Lexer) skipComment() {
 	}
 }
 
-// readString reads a string literal (content between quotes, without the quotes)
-func (l *Lexer) readString() string {
-	// Move past the opening quote
+// stringError describes a malformed string literal and where the problem is
+type stringError struct {
+	message string
+	line    int
+	column  int
+}
+
+// readString scans a string literal from its opening quote to just past its
+// closing delimiter: one quote, or three for a triple-quoted """ string.
+// It validates escape sequences (\n \t \r \" \\ \{ \} and \u{1F969}) and
+// {expr} interpolations, and reports whether any interpolations were found.
+// Decoding is left to SplitInterpolation. On a bad escape or an unclosed {
+// the rest of the literal is still consumed, so lexing resumes after the
+// closing quote. An unterminated literal is reported at its opening quote.
+func (l *Lexer) readString(triple bool) (bool, *stringError) {
+	openLine, openColumn := l.line, l.column
+	l.skipQuotes(triple)
+
+	var discard strings.Builder
+	var firstErr *stringError
+	interpolated := false
+
+	for !l.atStringEnd(triple) {
+		if l.ch == 0 {
+			return false, &stringError{"unterminated string literal", openLine, openColumn}
+		}
+
+		switch l.ch {
+		case '\\':
+			escLine, escColumn := l.line, l.column
+			l.readChar() // move past the backslash
+			if err := l.readEscape(&discard); err != "" && firstErr == nil {
+				firstErr = &stringError{err, escLine, escColumn}
+			}
+		case '{':
+			interpolated = true
+			braceLine, braceColumn := l.line, l.column
+			l.readChar() // move past '{'
+			if l.ch == '}' && firstErr == nil {
+				firstErr = &stringError{"empty interpolation {} in string literal", braceLine, braceColumn}
+			}
+			afterBrace := *l
+			if !l.skipInterpolation(triple) {
+				// Carry on from the brace as plain text, so the string still
+				// ends at its own closing quote
+				if firstErr == nil {
+					firstErr = &stringError{"unclosed { in string interpolation", braceLine, braceColumn}
+				}
+				*l = afterBrace
+				continue
+			}
+			l.readChar() // move past '}'
+		default:
+			l.readChar()
+		}
+	}
+
+	l.skipQuotes(triple)
+
+	if firstErr != nil {
+		return false, firstErr
+	}
+	return interpolated, nil
+}
+
+// isTripleQuote reports whether the lexer is at a """ delimiter
+func (l *Lexer) isTripleQuote() bool {
+	return l.ch == '"' && l.peekChar() == '"' && l.peekSecondChar() == '"'
+}
+
+// atStringEnd reports whether the lexer is at the closing delimiter of a string
+func (l *Lexer) atStringEnd(triple bool) bool {
+	if triple {
+		return l.isTripleQuote()
+	}
+	return l.ch == '"'
+}
+
+// skipQuotes moves past a string delimiter
+func (l *Lexer) skipQuotes(triple bool) {
 	l.readChar()
+	if triple {
+		l.readChar()
+		l.readChar()
+	}
+}
 
-	position := l.position
-	for l.ch != '"' && l.ch != 0 {
+// skipInterpolation advances from just after a '{' in a string to its matching
+// '}', stepping over nested braces and string literals. Returns false at EOF,
+// or at the end of the line unless the string is triple-quoted (multiline).
+func (l *Lexer) skipInterpolation(multiline bool) bool {
+	depth := 0
+	for {
+		switch l.ch {
+		case 0:
+			return false
+		case '\n':
+			if !multiline {
+				return false
+			}
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return true
+			}
+			depth--
+		case '"':
+			if !l.skipNestedString(multiline) {
+				return false
+			}
+			continue
+		}
+		l.readChar()
+	}
+}
+
+// skipNestedString moves past a string literal inside an interpolation,
+// including any interpolations of its own. Like skipInterpolation, it returns
+// false at EOF or at a line end it may not cross.
+func (l *Lexer) skipNestedString(multiline bool) bool {
+	triple := l.isTripleQuote()
+	l.skipQuotes(triple)
+	for !l.atStringEnd(triple) {
+		switch l.ch {
+		case 0:
+			return false
+		case '\n':
+			if !multiline {
+				return false
+			}
+		case '\\':
+			l.readChar()
+		case '{':
+			l.readChar()
+			if !l.skipInterpolation(multiline) {
+				return false
+			}
+		}
+		l.readChar()
+	}
+	l.skipQuotes(triple)
+	return true
+}
+
+// Segment is one piece of a string literal: literal text, or the source
+// of an embedded expression together with the position where it starts.
+type Segment struct {
+	Text   string
+	IsExpr bool
+	Line   int
+	Column int
+}
+
+// SplitInterpolation decodes the source of a string literal (including its
+// quotes, as read by the lexer) into text and expression segments. line and
+// column give the position of the opening quote, so embedded expressions
+// can be lexed with their real positions via NewAt.
+//
+// A triple-quoted string whose opening """ ends the line is a block string:
+// that first newline is dropped, as is a final line holding only the
+// indentation before the closing """, and the common indentation of the
+// remaining lines is stripped.
+func SplitInterpolation(source string, line, column int) []Segment {
+	l := NewAt(source, line, column)
+	triple := l.isTripleQuote()
+	l.skipQuotes(triple)
+
+	end := len(source) - 1
+	indent := 0
+	atLineStart := false
+	if triple {
+		end = len(source) - 3
+		if l.ch == '\r' && l.peekChar() == '\n' {
+			l.readChar() // a CRLF line ending opens a block too
+		}
+		if l.ch == '\n' {
+			l.readChar()
+			end, indent = blockLayout(source[l.position:end], l.position)
+			atLineStart = true
+		}
+	}
+
+	segments := []Segment{}
+	var text strings.Builder
+	textLine, textColumn := l.line, l.column
+	flush := func() {
+		if text.Len() > 0 {
+			segments = append(segments, Segment{Text: text.String(), Line: textLine, Column: textColumn})
+			text.Reset()
+		}
+	}
+
+	for l.position < end {
+		if atLineStart {
+			// Strip the common indentation from each line of a block string
+			for i := 0; i < indent && (l.ch == ' ' || l.ch == '\t'); i++ {
+				l.readChar()
+			}
+			atLineStart = false
+			continue
+		}
+
+		if text.Len() == 0 {
+			textLine, textColumn = l.line, l.column
+		}
+
+		switch l.ch {
+		case '\\':
+			l.readChar()
+			l.readEscape(&text) // already validated when the token was read
+		case '{':
+			flush()
+			l.readChar() // move past '{'
+			start, exprLine, exprColumn := l.position, l.line, l.column
+			l.skipInterpolation(triple)
+			segments = append(segments, Segment{Text: source[start:l.position], IsExpr: true, Line: exprLine, Column: exprColumn})
+			l.readChar() // move past '}'
+		default:
+			atLineStart = l.ch == '\n' && indent > 0
+			text.WriteRune(l.ch)
+			l.readChar()
+		}
+	}
+	flush()
+
+	return segments
+}
+
+// blockLayout measures the body of a block string, which starts at offset in
+// the literal's source. It returns where the body ends (dropping a last line
+// that holds only whitespace) and the indentation shared by non-blank lines.
+// Lines may end in "\r\n" as well as "\n".
+func blockLayout(body string, offset int) (int, int) {
+	end := offset + len(body)
+	lines := strings.Split(body, "\n")
+	if last := lines[len(lines)-1]; strings.TrimLeft(last, " \t") == "" && len(lines) > 1 {
+		end -= len(last) + 1 // the closing line and the newline before it
+		lines = lines[:len(lines)-1]
+		if strings.HasSuffix(lines[len(lines)-1], "\r") {
+			end-- // and the carriage return of a CRLF
+		}
+	}
+
+	indent := -1
+	for _, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if width := len(line) - len(trimmed); indent < 0 || width < indent {
+			indent = width
+		}
+	}
+	if indent < 0 {
+		indent = 0
+	}
+	return end, indent
+}
+
+// readEscape decodes the escape sequence after a backslash into out.
+// Returns a description of the problem for an invalid escape, or "".
+func (l *Lexer) readEscape(out *strings.Builder) string {
+	switch l.ch {
+	case 'n':
+		out.WriteByte('\n')
+	case 't':
+		out.WriteByte('\t')
+	case 'r':
+		out.WriteByte('\r')
+	case '"':
+		out.WriteByte('"')
+	case '\\':
+		out.WriteByte('\\')
+	case '{', '}':
+		// Literal braces in a string that would otherwise interpolate
+		out.WriteRune(l.ch)
+	case 'u':
+		return l.readUnicodeEscape(out)
+	case 0:
+		// Let readString report the unterminated literal
+		return ""
+	default:
+		invalid := l.ch
 		l.readChar()
+		return fmt.Sprintf("invalid escape sequence \\%c in string literal", invalid)
 	}
+	l.readChar()
+	return ""
+}
 
-	// Extract the string content (without quotes)
-	str := l.input[position:l.position]
+// readUnicodeEscape decodes \u{XXXX}: 1 to 6 hex digits naming a code point
+func (l *Lexer) readUnicodeEscape(out *strings.Builder) string {
+	l.readChar() // move past 'u'
+	if l.ch != '{' {
+		return "invalid unicode escape: expected \\u{...}"
+	}
+	l.readChar()
 
-	// Move past the closing quote (if we found one)
-	if l.ch == '"' {
+	position := l.position
+	for l.ch != '}' && l.ch != '"' && l.ch != 0 {
 		l.readChar()
 	}
+	if l.ch != '}' {
+		return "invalid unicode escape: missing closing }"
+	}
+	digits := l.input[position:l.position]
+	l.readChar() // move past '}'
+
+	if len(digits) == 0 || len(digits) > 6 {
+		return fmt.Sprintf("invalid unicode escape \\u{%s}: expected 1 to 6 hex digits", digits)
+	}
+	code, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || !utf8.ValidRune(rune(code)) {
+		return fmt.Sprintf("invalid unicode escape \\u{%s}: not a valid code point", digits)
+	}
 
-	return str
+	out.WriteRune(rune(code))
+	return ""
 }
 
 // newToken creates a new token with the current line/column position
-func (l *Lexer) newToken(tokenType token.TokenType, ch byte) token.Token {
+func (l *Lexer) newToken(tokenType token.TokenType, ch rune) token.Token {
 	return token.Token{
 		Type:    tokenType,
 		Literal: string(ch),
@@ -233,12 +616,12 @@ func (l *Lexer) newToken(tokenType token.TokenType, ch byte) token.Token {
 	}
 }
 
-// isLetter checks if a character is a letter or underscore
-func isLetter(ch byte) bool {
-	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+// isLetter checks if a character is a letter (in any script) or underscore
+func isLetter(ch rune) bool {
+	return unicode.IsLetter(ch) || ch == '_'
 }
 
-// isDigit checks if a character is a digit
-func isDigit(ch byte) bool {
+// isDigit checks if a character is an ASCII digit
+func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9'
 }
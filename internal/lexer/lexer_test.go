@@ -23,6 +23,52 @@ func TestTokenizeIntegers(t *testing.T) {
 	assert.Equal(t, token.EOF, tok.Type)
 }
 
+func TestTokenizeFloats(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{"3.14", token.FLOAT, "3.14"},
+		{"0.5", token.FLOAT, "0.5"},
+		{"100.0", token.FLOAT, "100.0"},
+		{"42", token.INT, "42"},
+	}
+
+	for _, tt := range tests {
+		l := New(tt.input)
+		tok := l.NextToken()
+
+		assert.Equal(t, tt.expectedType, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, tt.expectedLiteral, tok.Literal, "Input: %s", tt.input)
+
+		tok = l.NextToken()
+		assert.Equal(t, token.EOF, tok.Type, "Input: %s should be followed by EOF", tt.input)
+	}
+}
+
+func TestTokenizeNumberFollowedByDot(t *testing.T) {
+	// A dot without a digit after it is not part of the number
+	input := "3.x"
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.INT, "3"},
+		{token.DOT, "."},
+		{token.IDENT, "x"},
+		{token.EOF, ""},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+	}
+}
+
 func TestTokenizeStringLiterals(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -42,6 +88,266 @@ func TestTokenizeStringLiterals(t *testing.T) {
 	}
 }
 
+func TestTokenizeStringEscapes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"line\nbreak"`, "line\nbreak"},
+		{`"tab\there"`, "tab\there"},
+		{`"carriage\rreturn"`, "carriage\rreturn"},
+		{`"say \"moo\""`, `say "moo"`},
+		{`"back\\slash"`, `back\slash`},
+		{`"\u{41}\u{1F969}"`, "A\U0001F969"},
+		{`"\u{e9}t\u{E9}"`, "été"},
+	}
+
+	for _, tt := range tests {
+		l := New(tt.input)
+		tok := l.NextToken()
+
+		assert.Equal(t, token.STRING, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, tt.expected, tok.Literal, "Input: %s", tt.input)
+		assert.Equal(t, token.EOF, l.NextToken().Type, "Input: %s", tt.input)
+	}
+}
+
+func TestTokenizeInvalidStringEscapes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		column   int
+	}{
+		{`"bad \q escape"`, `invalid escape sequence \q in string literal`, 6},
+		{`"\u0041"`, `invalid unicode escape: expected \u{...}`, 2},
+		{`"\u{}"`, `invalid unicode escape \u{}: expected 1 to 6 hex digits`, 2},
+		{`"\u{1234567}"`, `invalid unicode escape \u{1234567}: expected 1 to 6 hex digits`, 2},
+		{`"\u{zz}"`, `invalid unicode escape \u{zz}: not a valid code point`, 2},
+		{`"\u{D800}"`, `invalid unicode escape \u{D800}: not a valid code point`, 2},
+		{`"\u{41"`, `invalid unicode escape: missing closing }`, 2},
+	}
+
+	for _, tt := range tests {
+		l := New(tt.input + " x")
+		tok := l.NextToken()
+
+		assert.Equal(t, token.ILLEGAL, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, tt.expected, tok.Literal, "Input: %s", tt.input)
+		assert.Equal(t, 1, tok.Line, "Input: %s", tt.input)
+		assert.Equal(t, tt.column, tok.Column, "Input: %s", tt.input)
+
+		// Lexing resumes after the closing quote
+		tok = l.NextToken()
+		assert.Equal(t, token.IDENT, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, "x", tok.Literal, "Input: %s", tt.input)
+	}
+}
+
+func TestTokenizeUnterminatedString(t *testing.T) {
+	input := "prep a = 1\nprep s = \"never closed\nprep b = 2\n"
+	l := New(input)
+
+	var tok token.Token
+	for i := 0; i < 8; i++ {
+		tok = l.NextToken()
+	}
+
+	// Reported at the opening quote, not at the end of the file
+	assert.Equal(t, token.ILLEGAL, tok.Type)
+	assert.Equal(t, "unterminated string literal", tok.Literal)
+	assert.Equal(t, 2, tok.Line)
+	assert.Equal(t, 10, tok.Column)
+
+	assert.Equal(t, token.EOF, l.NextToken().Type)
+}
+
+func TestTokenizeInterpolatedString(t *testing.T) {
+	tests := []struct {
+		input        string
+		expectedType token.TokenType
+		expected     string
+	}{
+		// Interpolated literals keep their source, quotes included, for SplitInterpolation
+		{`"total: {sum + 1}"`, token.INTERPOLATED, `"total: {sum + 1}"`},
+		{`"{a}{b}"`, token.INTERPOLATED, `"{a}{b}"`},
+		{`"nested {"inner {x}" + "}"}"`, token.INTERPOLATED, `"nested {"inner {x}" + "}"}"`},
+		{`"deeper {"a {"b"} c"}"`, token.INTERPOLATED, `"deeper {"a {"b"} c"}"`},
+		{`"hash {{"k": 1}["k"]}"`, token.INTERPOLATED, `"hash {{"k": 1}["k"]}"`},
+		{`"braces \{not\} interpolated"`, token.STRING, "braces {not} interpolated"},
+	}
+
+	for _, tt := range tests {
+		l := New(tt.input)
+		tok := l.NextToken()
+
+		assert.Equal(t, tt.expectedType, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, tt.expected, tok.Literal, "Input: %s", tt.input)
+		assert.Equal(t, token.EOF, l.NextToken().Type, "Input: %s", tt.input)
+	}
+}
+
+func TestTokenizeMalformedInterpolation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		column   int
+	}{
+		{`"empty {} here"`, "empty interpolation {} in string literal", 8},
+		{`"open {sum"`, "unclosed { in string interpolation", 7},
+		{`"open {"`, "unclosed { in string interpolation", 7},
+		{`"nested {"{x"}"`, "unclosed { in string interpolation", 9},
+		// A string that really is unclosed is still reported at its quote
+		{`"open {sum`, "unterminated string literal", 1},
+	}
+
+	for _, tt := range tests {
+		tok := New(tt.input).NextToken()
+
+		assert.Equal(t, token.ILLEGAL, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, tt.expected, tok.Literal, "Input: %s", tt.input)
+		assert.Equal(t, tt.column, tok.Column, "Input: %s", tt.input)
+	}
+}
+
+func TestTokenizeUnclosedInterpolationStopsAtLineEnd(t *testing.T) {
+	// The unmatched brace must not swallow the source that follows
+	input := "prep s = \"a {b\"\nprep c = \"}\""
+	l := New(input)
+
+	expected := []struct {
+		tokenType token.TokenType
+		literal   string
+		line      int
+	}{
+		{token.PREP, "prep", 1},
+		{token.IDENT, "s", 1},
+		{token.ASSIGN, "=", 1},
+		{token.ILLEGAL, "unclosed { in string interpolation", 1},
+		{token.PREP, "prep", 2},
+		{token.IDENT, "c", 2},
+		{token.ASSIGN, "=", 2},
+		{token.STRING, "}", 2},
+		{token.EOF, "", 2},
+	}
+
+	for i, tt := range expected {
+		tok := l.NextToken()
+		assert.Equal(t, tt.tokenType, tok.Type, "token %d", i)
+		assert.Equal(t, tt.literal, tok.Literal, "token %d", i)
+		assert.Equal(t, tt.line, tok.Line, "token %d", i)
+	}
+
+	// A triple-quoted string's interpolation may still span lines
+	tok := New("\"\"\"total: {1 +\n   2}\"\"\"").NextToken()
+	assert.Equal(t, token.INTERPOLATED, tok.Type)
+}
+
+func TestTokenizeTripleQuotedStrings(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"""inline "quoted" text"""`, `inline "quoted" text`},
+		{"\"\"\"two\nlines\"\"\"", "two\nlines"},
+		// Block strings drop the first newline, the closing line, and common indentation
+		{"\"\"\"\n    Usage: beef <file>\n\n      --verbose\n    \"\"\"", "Usage: beef <file>\n\n  --verbose"},
+		{"\"\"\"\n\tTabbed\n\t\"\"\"", "Tabbed"},
+		// Content on the closing line is kept
+		{"\"\"\"\n  keep\n  last\"\"\"", "keep\nlast"},
+		{"\"\"\"\n  escaped\\n  newline\n\"\"\"", "escaped\n  newline"},
+		{`""""""`, ""},
+		{`"" "x"`, ""},
+	}
+
+	for _, tt := range tests {
+		tok := New(tt.input).NextToken()
+
+		assert.Equal(t, token.STRING, tok.Type, "Input: %s", tt.input)
+		assert.Equal(t, tt.expected, tok.Literal, "Input: %s", tt.input)
+	}
+}
+
+func TestTripleQuotedStringTracksLines(t *testing.T) {
+	input := "prep help = \"\"\"\n  one\n  two\n  \"\"\"\nprep x = 1"
+	l := New(input)
+
+	for i := 0; i < 3; i++ {
+		l.NextToken()
+	}
+	tok := l.NextToken()
+	assert.Equal(t, token.STRING, tok.Type)
+	assert.Equal(t, "one\ntwo", tok.Literal)
+	assert.Equal(t, 1, tok.Line)
+	assert.Equal(t, 13, tok.Column)
+
+	tok = l.NextToken()
+	assert.Equal(t, token.PREP, tok.Type)
+	assert.Equal(t, 5, tok.Line)
+	assert.Equal(t, 1, tok.Column)
+
+	unterminated := New("prep s = \"\"\"\n  never closed\"\n")
+	for i := 0; i < 3; i++ {
+		unterminated.NextToken()
+	}
+	tok = unterminated.NextToken()
+	assert.Equal(t, token.ILLEGAL, tok.Type)
+	assert.Equal(t, "unterminated string literal", tok.Literal)
+	assert.Equal(t, 1, tok.Line)
+	assert.Equal(t, 10, tok.Column)
+}
+
+func TestSplitInterpolation(t *testing.T) {
+	// "a\t{x}\n{ y + 1 }!" with its opening quote at line 3, column 9
+	segments := SplitInterpolation("\"a\\t{x}\\n{ y + 1 }!\"", 3, 9)
+
+	expected := []Segment{
+		{Text: "a\t", Line: 3, Column: 10},
+		{Text: "x", IsExpr: true, Line: 3, Column: 14},
+		{Text: "\n", Line: 3, Column: 16},
+		{Text: " y + 1 ", IsExpr: true, Line: 3, Column: 19},
+		{Text: "!", Line: 3, Column: 27},
+	}
+	assert.Equal(t, expected, segments)
+}
+
+func TestSplitInterpolationInBlockString(t *testing.T) {
+	segments := SplitInterpolation("\"\"\"\n    Hi {name}!\n      bye\n    \"\"\"", 1, 1)
+
+	expected := []Segment{
+		{Text: "Hi ", Line: 2, Column: 5},
+		{Text: "name", IsExpr: true, Line: 2, Column: 9},
+		{Text: "!\n  bye", Line: 2, Column: 14},
+	}
+	assert.Equal(t, expected, segments)
+}
+
+func TestSplitInterpolationInCRLFBlockString(t *testing.T) {
+	segments := SplitInterpolation("\"\"\"\r\n    Hi {name}!\r\n\r\n      bye\r\n    \"\"\"", 1, 1)
+
+	expected := []Segment{
+		{Text: "Hi ", Line: 2, Column: 5},
+		{Text: "name", IsExpr: true, Line: 2, Column: 9},
+		{Text: "!\r\n\r\n  bye", Line: 2, Column: 14},
+	}
+	assert.Equal(t, expected, segments)
+}
+
+func TestNewAtKeepsSourcePositions(t *testing.T) {
+	l := NewAt("a +\n b", 4, 12)
+
+	tok := l.NextToken()
+	assert.Equal(t, 4, tok.Line)
+	assert.Equal(t, 12, tok.Column)
+
+	tok = l.NextToken()
+	assert.Equal(t, 4, tok.Line)
+	assert.Equal(t, 14, tok.Column)
+
+	tok = l.NextToken()
+	assert.Equal(t, 5, tok.Line)
+	assert.Equal(t, 2, tok.Column)
+}
+
 // ========================================
 // Identifiers
 // ========================================
@@ -89,6 +395,65 @@ func TestTokenizeIdentifiersWithDigits(t *testing.T) {
 	}
 }
 
+func TestTokenizeUnicodeIdentifiers(t *testing.T) {
+	tests := []string{"café", "牛肉", "größe_2", "_ñ", "Δx", "cafe\u0301"}
+
+	for _, input := range tests {
+		l := New(input)
+		tok := l.NextToken()
+
+		assert.Equal(t, token.IDENT, tok.Type, "Input: %s should be IDENT", input)
+		assert.Equal(t, input, tok.Literal, "Input: %s literal mismatch", input)
+		assert.Equal(t, token.EOF, l.NextToken().Type, "Input: %s should be followed by EOF", input)
+	}
+}
+
+func TestColumnsCountCharacters(t *testing.T) {
+	// Every multibyte character advances the column by one
+	input := "prep s = \"🥩é\" + café\nx"
+	l := New(input)
+
+	expected := []struct {
+		literal string
+		line    int
+		column  int
+	}{
+		{"prep", 1, 1},
+		{"s", 1, 6},
+		{"=", 1, 8},
+		{"🥩é", 1, 10},
+		{"+", 1, 15},
+		{"café", 1, 17},
+		{"x", 2, 1},
+	}
+
+	for _, exp := range expected {
+		tok := l.NextToken()
+		assert.Equal(t, exp.literal, tok.Literal)
+		assert.Equal(t, exp.line, tok.Line, "Line of %s", exp.literal)
+		assert.Equal(t, exp.column, tok.Column, "Column of %s", exp.literal)
+	}
+}
+
+func TestTokenizeIllegalUnicode(t *testing.T) {
+	l := New("prep x = 1 € 2 \xff")
+
+	for i := 0; i < 4; i++ {
+		l.NextToken()
+	}
+
+	tok := l.NextToken()
+	assert.Equal(t, token.ILLEGAL, tok.Type)
+	assert.Equal(t, "€", tok.Literal)
+	assert.Equal(t, 12, tok.Column)
+
+	l.NextToken()
+	tok = l.NextToken()
+	assert.Equal(t, token.ILLEGAL, tok.Type)
+	assert.Equal(t, "invalid UTF-8 encoding", tok.Literal)
+	assert.Equal(t, 16, tok.Column)
+}
+
 // ========================================
 // Keywords
 // ========================================
@@ -117,6 +482,86 @@ func TestTokenizeKeywords(t *testing.T) {
 	}
 }
 
+func TestTokenizeHeresyAsElse(t *testing.T) {
+	l := New("heresy")
+
+	tok := l.NextToken()
+	assert.Equal(t, token.ELSE, tok.Type)
+	assert.Equal(t, "heresy", tok.Literal)
+}
+
+func TestTokenizeLoopControlKeywords(t *testing.T) {
+	input := "sacrifice repent"
+	l := New(input)
+
+	tok := l.NextToken()
+	assert.Equal(t, token.SACRIFICE, tok.Type)
+	assert.Equal(t, "sacrifice", tok.Literal)
+
+	tok = l.NextToken()
+	assert.Equal(t, token.REPENT, tok.Type)
+	assert.Equal(t, "repent", tok.Literal)
+
+	tok = l.NextToken()
+	assert.Equal(t, token.EOF, tok.Type)
+}
+
+func TestTokenizePackKeyword(t *testing.T) {
+	input := "pack x = 1"
+	l := New(input)
+
+	tok := l.NextToken()
+	assert.Equal(t, token.PACK, tok.Type)
+	assert.Equal(t, "pack", tok.Literal)
+
+	tok = l.NextToken()
+	assert.Equal(t, token.IDENT, tok.Type)
+	assert.Equal(t, "x", tok.Literal)
+}
+
+func TestTokenizeErrorHandlingKeywords(t *testing.T) {
+	input := "try catch smite"
+	l := New(input)
+
+	expected := []token.TokenType{token.TRY, token.CATCH, token.SMITE, token.EOF}
+	for _, tt := range expected {
+		tok := l.NextToken()
+		assert.Equal(t, tt, tok.Type)
+	}
+}
+
+func TestTokenizeCongregationKeyword(t *testing.T) {
+	l := New("congregation Steak(cut)")
+
+	tok := l.NextToken()
+	assert.Equal(t, token.CONGREGATION, tok.Type)
+	assert.Equal(t, "congregation", tok.Literal)
+
+	tok = l.NextToken()
+	assert.Equal(t, token.IDENT, tok.Type)
+}
+
+func TestTokenizeLogicalKeywords(t *testing.T) {
+	input := "and or not"
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.AND_WORD, "and"},
+		{token.OR_WORD, "or"},
+		{token.NOT_WORD, "not"},
+		{token.EOF, ""},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+	}
+}
+
 func TestHandleFeastWhileKeyword(t *testing.T) {
 	input := "feast while"
 	l := New(input)
@@ -135,6 +580,30 @@ func TestHandleFeastWhileKeyword(t *testing.T) {
 	assert.Equal(t, token.EOF, tok.Type)
 }
 
+func TestTokenizeFeastForLoop(t *testing.T) {
+	input := "feast for x in items:"
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.FEAST_WHILE, "feast"},
+		{token.FOR, "for"},
+		{token.IDENT, "x"},
+		{token.IN, "in"},
+		{token.IDENT, "items"},
+		{token.COLON, ":"},
+		{token.EOF, ""},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+	}
+}
+
 func TestTokenizeWrangleKeyword(t *testing.T) {
 	input := "wrangle"
 	l := New(input)
@@ -224,6 +693,37 @@ func TestTokenizeDotOperator(t *testing.T) {
 	assert.Equal(t, token.EOF, tok.Type)
 }
 
+func TestTokenizeEllipsis(t *testing.T) {
+	input := "(level, ...parts) a.b .."
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+		column          int
+	}{
+		{token.LPAREN, "(", 1},
+		{token.IDENT, "level", 2},
+		{token.COMMA, ",", 7},
+		{token.ELLIPSIS, "...", 9},
+		{token.IDENT, "parts", 12},
+		{token.RPAREN, ")", 17},
+		{token.IDENT, "a", 19},
+		{token.DOT, ".", 20},
+		{token.IDENT, "b", 21},
+		{token.DOT, ".", 23},
+		{token.DOT, ".", 24},
+		{token.EOF, "", 25},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+		assert.Equal(t, expected.column, tok.Column, "token %d column mismatch", i)
+	}
+}
+
 // ========================================
 // Delimiters
 // ========================================
@@ -250,6 +750,82 @@ func TestTokenizeDelimiters(t *testing.T) {
 	}
 }
 
+func TestLexerTokenizesArrayLiterals(t *testing.T) {
+	input := `[1, "beef", true]`
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.LBRACKET, "["},
+		{token.INT, "1"},
+		{token.COMMA, ","},
+		{token.STRING, "beef"},
+		{token.COMMA, ","},
+		{token.TRUE, "true"},
+		{token.RBRACKET, "]"},
+		{token.EOF, ""},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+	}
+}
+
+func TestLexerTokenizesIndexExpressions(t *testing.T) {
+	input := "numbers[0] = 99"
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.IDENT, "numbers"},
+		{token.LBRACKET, "["},
+		{token.INT, "0"},
+		{token.RBRACKET, "]"},
+		{token.ASSIGN, "="},
+		{token.INT, "99"},
+		{token.EOF, ""},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+	}
+}
+
+func TestLexerTokenizesHashLiterals(t *testing.T) {
+	input := `{"name": "Beef", 1: true}`
+	l := New(input)
+
+	expectedTokens := []struct {
+		expectedType    token.TokenType
+		expectedLiteral string
+	}{
+		{token.LBRACE, "{"},
+		{token.STRING, "name"},
+		{token.COLON, ":"},
+		{token.STRING, "Beef"},
+		{token.COMMA, ","},
+		{token.INT, "1"},
+		{token.COLON, ":"},
+		{token.TRUE, "true"},
+		{token.RBRACE, "}"},
+		{token.EOF, ""},
+	}
+
+	for i, expected := range expectedTokens {
+		tok := l.NextToken()
+		assert.Equal(t, expected.expectedType, tok.Type, "token %d type mismatch", i)
+		assert.Equal(t, expected.expectedLiteral, tok.Literal, "token %d literal mismatch", i)
+	}
+}
+
 // ========================================
 // Whitespace and Comments
 // ========================================
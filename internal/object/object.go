@@ -2,6 +2,11 @@ package object
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/elitwilson/beeflang/internal/ast"
 )
@@ -31,6 +36,25 @@ func (i *Integer) Inspect() string {
 	return fmt.Sprintf("%d", i.Value)
 }
 
+// Float represents a floating point value at runtime.
+type Float struct {
+	Value float64
+}
+
+func (f *Float) Type() string {
+	return "FLOAT"
+}
+
+// Inspect always shows a decimal point so floats are distinguishable
+// from integers when printed (2.0, not 2).
+func (f *Float) Inspect() string {
+	str := strconv.FormatFloat(f.Value, 'f', -1, 64)
+	if !strings.ContainsAny(str, ".IN") { // skip Inf and NaN
+		str += ".0"
+	}
+	return str
+}
+
 // Boolean represents a boolean value at runtime.
 type Boolean struct {
 	Value bool
@@ -48,6 +72,7 @@ func (b *Boolean) Inspect() string {
 }
 
 // String represents a string value at runtime.
+// Length, indexing and iteration count code points (characters), not bytes.
 type String struct {
 	Value string
 }
@@ -60,6 +85,286 @@ func (s *String) Inspect() string {
 	return s.Value
 }
 
+// Len returns the number of characters (code points) in the string.
+func (s *String) Len() int64 {
+	return int64(utf8.RuneCountInString(s.Value))
+}
+
+// Array represents an ordered collection of values at runtime.
+// Elements can be any mix of types, and arrays are mutated in place by index assignment.
+type Array struct {
+	Elements []Object
+}
+
+func (a *Array) Type() string {
+	return "ARRAY"
+}
+
+func (a *Array) Inspect() string {
+	return a.inspect(make(map[Object]bool))
+}
+
+func (a *Array) inspect(printing map[Object]bool) string {
+	if printing[a] {
+		return "[...]"
+	}
+	printing[a] = true
+	defer delete(printing, a)
+
+	elements := make([]string, len(a.Elements))
+	for i, el := range a.Elements {
+		elements[i] = inspectNested(el, printing)
+	}
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+// inspectNested prints a value held inside an array, hash or instance.
+// printing holds the containers whose Inspect is already in progress, so a
+// container that (directly or indirectly) holds itself prints as [...],
+// {...} or Name(...) instead of recursing forever.
+func inspectNested(obj Object, printing map[Object]bool) string {
+	switch obj := obj.(type) {
+	case *Array:
+		return obj.inspect(printing)
+	case *Hash:
+		return obj.inspect(printing)
+	case *Instance:
+		return obj.inspect(printing)
+	}
+	return obj.Inspect()
+}
+
+// HashKey identifies a hashable value inside a Hash.
+// Two values with the same type and value produce the same key, so
+// "beef" used twice refers to one entry even though the String objects differ.
+type HashKey struct {
+	Type  string
+	Value string
+}
+
+// Hashable is implemented by values that can be used as hash keys.
+// Only INTEGER, STRING and BOOLEAN are hashable.
+type Hashable interface {
+	Object
+	HashKey() HashKey
+}
+
+func (i *Integer) HashKey() HashKey {
+	return HashKey{Type: i.Type(), Value: strconv.FormatInt(i.Value, 10)}
+}
+
+func (b *Boolean) HashKey() HashKey {
+	return HashKey{Type: b.Type(), Value: b.Inspect()}
+}
+
+func (s *String) HashKey() HashKey {
+	return HashKey{Type: s.Type(), Value: s.Value}
+}
+
+// HashPair stores the original key object alongside its value,
+// so the key can be printed or iterated over later.
+type HashPair struct {
+	Key   Object
+	Value Object
+}
+
+// Hash represents a hash map (dictionary) at runtime.
+// Entries remember insertion order so Inspect() output is stable.
+type Hash struct {
+	Pairs map[HashKey]HashPair
+	order []HashKey // keys in insertion order
+}
+
+// NewHash creates an empty hash.
+func NewHash() *Hash {
+	return &Hash{Pairs: make(map[HashKey]HashPair)}
+}
+
+func (h *Hash) Type() string {
+	return "HASH"
+}
+
+func (h *Hash) Inspect() string {
+	return h.inspect(make(map[Object]bool))
+}
+
+func (h *Hash) inspect(printing map[Object]bool) string {
+	if printing[h] {
+		return "{...}"
+	}
+	printing[h] = true
+	defer delete(printing, h)
+
+	pairs := make([]string, 0, len(h.order))
+	for _, pair := range h.Entries() {
+		pairs = append(pairs, pair.Key.Inspect()+": "+inspectNested(pair.Value, printing))
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// Get retrieves the value stored under key.
+func (h *Hash) Get(key Hashable) (Object, bool) {
+	pair, ok := h.Pairs[key.HashKey()]
+	if !ok {
+		return nil, false
+	}
+	return pair.Value, true
+}
+
+// Set stores val under key, replacing any existing value.
+func (h *Hash) Set(key Hashable, val Object) {
+	hashKey := key.HashKey()
+	if _, exists := h.Pairs[hashKey]; !exists {
+		h.order = append(h.order, hashKey)
+	}
+	h.Pairs[hashKey] = HashPair{Key: key, Value: val}
+}
+
+// Entries returns all key/value pairs in insertion order.
+func (h *Hash) Entries() []HashPair {
+	entries := make([]HashPair, 0, len(h.order))
+	for _, hashKey := range h.order {
+		entries = append(entries, h.Pairs[hashKey])
+	}
+	return entries
+}
+
+// Range represents a lazy sequence of integers from Start up to (but not
+// including) End, counting by Step. Created by the range() builtin.
+type Range struct {
+	Start int64
+	End   int64
+	Step  int64 // never zero; negative steps count down
+}
+
+func (r *Range) Type() string {
+	return "RANGE"
+}
+
+func (r *Range) Inspect() string {
+	if r.Step == 1 {
+		return fmt.Sprintf("range(%d, %d)", r.Start, r.End)
+	}
+	return fmt.Sprintf("range(%d, %d, %d)", r.Start, r.End, r.Step)
+}
+
+// Len returns how many integers the range produces. A range too long to
+// count in an int64, like range(-9223372036854775808, 9223372036854775807),
+// reports the largest int64.
+func (r *Range) Len() int64 {
+	span := r.span(r.Start)
+	if span == 0 {
+		return 0
+	}
+	n := (span-1)/r.stride() + 1
+	if n > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(n)
+}
+
+// span returns the distance from 'from' to End in the direction of Step, or
+// 0 when End is not ahead. It is unsigned so it cannot overflow.
+func (r *Range) span(from int64) uint64 {
+	if r.Step > 0 && from < r.End {
+		return uint64(r.End) - uint64(from)
+	}
+	if r.Step < 0 && from > r.End {
+		return uint64(from) - uint64(r.End)
+	}
+	return 0
+}
+
+// stride returns the size of Step, unsigned so a Step of the smallest int64
+// still has a size.
+func (r *Range) stride() uint64 {
+	if r.Step < 0 {
+		return -uint64(r.Step)
+	}
+	return uint64(r.Step)
+}
+
+// Iterator produces the elements of an iterable value one at a time.
+// Next returns false once the sequence is exhausted.
+type Iterator interface {
+	Next() (Object, bool)
+}
+
+// Iterable is implemented by values that can be looped over with 'feast for'.
+// New collection types only need to implement this to work with for loops.
+type Iterable interface {
+	Object
+	Iterator() Iterator
+}
+
+// iteratorFunc adapts a plain function to the Iterator interface.
+type iteratorFunc func() (Object, bool)
+
+func (f iteratorFunc) Next() (Object, bool) {
+	return f()
+}
+
+// Iterator yields the array's elements in order. The length is checked on
+// every step, so element assignments made during the loop are visible.
+func (a *Array) Iterator() Iterator {
+	i := 0
+	return iteratorFunc(func() (Object, bool) {
+		if i >= len(a.Elements) {
+			return nil, false
+		}
+		el := a.Elements[i]
+		i++
+		return el, true
+	})
+}
+
+// Iterator yields each character of the string as a one-character String.
+func (s *String) Iterator() Iterator {
+	chars := []rune(s.Value)
+	i := 0
+	return iteratorFunc(func() (Object, bool) {
+		if i >= len(chars) {
+			return nil, false
+		}
+		ch := chars[i]
+		i++
+		return &String{Value: string(ch)}, true
+	})
+}
+
+// Iterator yields the hash's keys in insertion order.
+func (h *Hash) Iterator() Iterator {
+	entries := h.Entries()
+	i := 0
+	return iteratorFunc(func() (Object, bool) {
+		if i >= len(entries) {
+			return nil, false
+		}
+		key := entries[i].Key
+		i++
+		return key, true
+	})
+}
+
+// Iterator yields the range's integers without materializing them.
+func (r *Range) Iterator() Iterator {
+	current, done := r.Start, r.span(r.Start) == 0
+	return iteratorFunc(func() (Object, bool) {
+		if done {
+			return nil, false
+		}
+		val := current
+		// Stop at the last integer instead of stepping past End, which
+		// overflows near the int64 limits
+		if r.span(current) <= r.stride() {
+			done = true
+		} else {
+			current += r.Step
+		}
+		return &Integer{Value: val}, true
+	})
+}
+
 // Null represents the absence of a value.
 // Used for functions that don't return anything, uninitialized variables, etc.
 type Null struct{}
@@ -75,7 +380,10 @@ func (n *Null) Inspect() string {
 // Function represents a function at runtime.
 // It stores the function's parameters, body, and the environment where it was defined (closure).
 type Function struct {
+	Name       string // "" for anonymous functions; "Steak.describe" for methods
 	Parameters []*ast.Identifier
+	Defaults   []ast.Expression // Default value per parameter, nil when required
+	Rest       *ast.Identifier  // Collects extra positional arguments into an array; nil if none
 	Body       *ast.BlockStatement
 	Env        *Environment // Closure: captures environment where function was defined
 }
@@ -88,6 +396,103 @@ func (f *Function) Inspect() string {
 	return "<function>"
 }
 
+// RequiredParameters returns how many parameters have no default value.
+// Parameters with defaults always come last, so these are the leading ones.
+func (f *Function) RequiredParameters() int {
+	required := 0
+	for i := range f.Parameters {
+		if i >= len(f.Defaults) || f.Defaults[i] == nil {
+			required++
+		}
+	}
+	return required
+}
+
+// StructType is a record type declared with congregation. Calling it like a
+// function builds an Instance, taking one argument per field in order.
+type StructType struct {
+	Name    string
+	Fields  []string
+	Methods map[string]*Function
+}
+
+func (st *StructType) Type() string {
+	return "CONGREGATION"
+}
+
+func (st *StructType) Inspect() string {
+	return fmt.Sprintf("<congregation %s>", st.Name)
+}
+
+// Instance is a value of a StructType. Its fields are fixed by the type,
+// but their values can be reassigned (steak.weight = 12).
+type Instance struct {
+	Struct *StructType
+	Fields map[string]Object
+}
+
+func (i *Instance) Type() string {
+	return "INSTANCE"
+}
+
+// Inspect prints the type name and fields in declaration order: Steak(cut: ribeye, weight: 12)
+func (i *Instance) Inspect() string {
+	return i.inspect(make(map[Object]bool))
+}
+
+func (i *Instance) inspect(printing map[Object]bool) string {
+	if printing[i] {
+		return i.Struct.Name + "(...)"
+	}
+	printing[i] = true
+	defer delete(printing, i)
+
+	fields := make([]string, len(i.Struct.Fields))
+	for idx, name := range i.Struct.Fields {
+		fields[idx] = name + ": " + inspectNested(i.Fields[name], printing)
+	}
+	return i.Struct.Name + "(" + strings.Join(fields, ", ") + ")"
+}
+
+// Get looks up a field, then a method bound to this instance.
+func (i *Instance) Get(name string) (Object, bool) {
+	if val, ok := i.Fields[name]; ok {
+		return val, true
+	}
+	if method, ok := i.Struct.Methods[name]; ok {
+		return &BoundMethod{Receiver: i, Method: method}, true
+	}
+	return nil, false
+}
+
+// MemberNames returns the names of all fields and methods, sorted.
+func (i *Instance) MemberNames() []string {
+	names := make([]string, 0, len(i.Fields)+len(i.Struct.Methods))
+	for name := range i.Fields {
+		names = append(names, name)
+	}
+	for name := range i.Struct.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BoundMethod is a method taken from an instance (steak.describe).
+// Calling it passes the instance as the method's first parameter (self).
+type BoundMethod struct {
+	Receiver *Instance
+	Method   *Function
+}
+
+func (bm *BoundMethod) Type() string {
+	return "BOUND_METHOD"
+}
+
+func (bm *BoundMethod) Inspect() string {
+	return fmt.Sprintf("<method of %s>", bm.Receiver.Struct.Name)
+}
+
 // ReturnValue wraps a value that's being returned from a function.
 // This wrapper allows us to distinguish between a normal evaluation result
 // and an early return statement, so we can stop executing and unwind the call stack.
@@ -103,6 +508,30 @@ func (rv *ReturnValue) Inspect() string {
 	return rv.Value.Inspect()
 }
 
+// BreakSignal is produced by 'sacrifice'. Like ReturnValue, it stops the
+// enclosing blocks from executing and unwinds until the innermost loop catches it.
+type BreakSignal struct{}
+
+func (bs *BreakSignal) Type() string {
+	return "BREAK_SIGNAL"
+}
+
+func (bs *BreakSignal) Inspect() string {
+	return "sacrifice"
+}
+
+// ContinueSignal is produced by 'repent'. It unwinds to the innermost loop,
+// which then moves on to its next iteration.
+type ContinueSignal struct{}
+
+func (cs *ContinueSignal) Type() string {
+	return "CONTINUE_SIGNAL"
+}
+
+func (cs *ContinueSignal) Inspect() string {
+	return "repent"
+}
+
 // Environment stores variable bindings (name -> value mappings).
 // It supports nested scopes through the `outer` pointer, enabling block-level scoping.
 //
@@ -115,14 +544,17 @@ func (rv *ReturnValue) Inspect() string {
 //   inner.Get("x")  // finds x in outer scope
 //   inner.Get("y")  // finds y in inner scope
 type Environment struct {
-	store map[string]Object
-	outer *Environment // pointer to enclosing (parent) scope
+	store     map[string]Object
+	constants map[string]bool // names declared with pack in this scope
+	outer     *Environment    // pointer to enclosing (parent) scope
+	file      string          // source file this scope belongs to (empty if unknown)
+	call      *Call           // function call this scope was created for, if any
 }
 
 // NewEnvironment creates a new environment with no outer scope (global scope).
 func NewEnvironment() *Environment {
 	s := make(map[string]Object)
-	return &Environment{store: s, outer: nil}
+	return &Environment{store: s, constants: make(map[string]bool), outer: nil}
 }
 
 // NewEnclosedEnvironment creates a new environment enclosed by an outer environment.
@@ -133,6 +565,14 @@ func NewEnclosedEnvironment(outer *Environment) *Environment {
 	return env
 }
 
+// NewCallEnvironment creates the scope a function call runs in: enclosed by
+// the function's closure environment, and recording the call itself.
+func NewCallEnvironment(outer *Environment, call *Call) *Environment {
+	env := NewEnclosedEnvironment(outer)
+	env.call = call
+	return env
+}
+
 // Get retrieves a variable from the environment.
 // It searches the current scope first, then walks up the outer scopes.
 // Returns (value, true) if found, (nil, false) if not found.
@@ -152,12 +592,86 @@ func (e *Environment) Set(name string, val Object) Object {
 	return val
 }
 
+// SetConstant stores an immutable binding (pack) in the current scope.
+func (e *Environment) SetConstant(name string, val Object) Object {
+	e.store[name] = val
+	e.constants[name] = true
+	return val
+}
+
+// IsConstant reports whether name resolves to a pack binding.
+// Only the scope that declares the name decides, so a prep in an inner
+// scope can shadow an outer constant.
+func (e *Environment) IsConstant(name string) bool {
+	if _, ok := e.store[name]; ok {
+		return e.constants[name]
+	}
+	if e.outer != nil {
+		return e.outer.IsConstant(name)
+	}
+	return false
+}
+
+// DeclaresConstant reports whether this scope itself declares name with pack.
+// Used to reject redeclaring a constant in the same scope.
+func (e *Environment) DeclaresConstant(name string) bool {
+	return e.constants[name]
+}
+
+// Assign updates an existing variable in the scope that declared it,
+// walking up the outer scopes. Returns false if no scope has the variable.
+func (e *Environment) Assign(name string, val Object) bool {
+	if _, ok := e.store[name]; ok {
+		e.store[name] = val
+		return true
+	}
+	if e.outer != nil {
+		return e.outer.Assign(name, val)
+	}
+	return false
+}
+
+// SetFile records the source file whose code runs in this environment.
+// Enclosed scopes inherit it, so wrangle can resolve modules relative to
+// the importing file.
+func (e *Environment) SetFile(path string) {
+	e.file = path
+}
+
+// File returns the source file of the nearest scope that has one.
+func (e *Environment) File() string {
+	if e.file == "" && e.outer != nil {
+		return e.outer.File()
+	}
+	return e.file
+}
+
+// Call returns the function call whose code runs in this environment, or nil
+// for code at the top level of a file.
+func (e *Environment) Call() *Call {
+	if e.call == nil && e.outer != nil {
+		return e.outer.Call()
+	}
+	return e.call
+}
+
+// Bindings returns a copy of the names declared in this scope only.
+func (e *Environment) Bindings() map[string]Object {
+	bindings := make(map[string]Object, len(e.store))
+	for name, val := range e.store {
+		bindings[name] = val
+	}
+	return bindings
+}
+
 // Singleton instances used throughout the interpreter for efficiency.
 // Instead of creating new objects, we reuse these single instances.
 var (
-	NULL  = &Null{}
-	TRUE  = &Boolean{Value: true}
-	FALSE = &Boolean{Value: false}
+	NULL     = &Null{}
+	TRUE     = &Boolean{Value: true}
+	FALSE    = &Boolean{Value: false}
+	BREAK    = &BreakSignal{}
+	CONTINUE = &ContinueSignal{}
 )
 
 // Module represents a module/namespace containing functions and values.
@@ -181,6 +695,16 @@ func (m *Module) Get(name string) (Object, bool) {
 	return obj, ok
 }
 
+// MemberNames returns the names of all members, sorted.
+func (m *Module) MemberNames() []string {
+	names := make([]string, 0, len(m.Members))
+	for name := range m.Members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Set stores a member in the module by name.
 func (m *Module) Set(name string, val Object) {
 	m.Members[name] = val
@@ -205,8 +729,9 @@ func (b *Builtin) Inspect() string {
 // instead of panicking or returning nil.
 //
 // Errors are first-class values in Beeflang - they implement the Object
-// interface and can be stored, passed around, and inspected. This design
-// supports future evolution toward explicit error handling (Result types).
+// interface and can be stored, passed around, and inspected. An Error
+// unwinds evaluation until a try/catch block intercepts it, which hands the
+// program an ErrorValue wrapping it.
 //
 // Location information (Line, Column, File) is included from the start
 // because Token already tracks this data and it's much easier to thread
@@ -216,6 +741,9 @@ type Error struct {
 	Line    int    // Line number where error occurred (from Token)
 	Column  int    // Column number where error occurred (from Token)
 	File    string // Source file path (empty string if not from file)
+	Cause   *Error // Wrapped error (errors.wrap), nil if none
+	// Function calls the error passed through, innermost first
+	Stack []Frame
 }
 
 func (e *Error) Type() string {
@@ -223,13 +751,117 @@ func (e *Error) Type() string {
 }
 
 func (e *Error) Inspect() string {
-	if e.File != "" {
-		return fmt.Sprintf("Error at %s:%d:%d - %s",
+	var out strings.Builder
+	switch {
+	case e.File != "":
+		fmt.Fprintf(&out, "Error at %s:%d:%d - %s",
 			e.File, e.Line, e.Column, e.Message)
-	}
-	if e.Line > 0 {
-		return fmt.Sprintf("Error at line %d, column %d - %s",
+	case e.Line > 0:
+		fmt.Fprintf(&out, "Error at line %d, column %d - %s",
 			e.Line, e.Column, e.Message)
+	default:
+		out.WriteString("Error: " + e.Message)
+	}
+
+	// The stack trace, one call per line. A run of identical frames
+	// (deep recursion) is shown once with a count, and a trace that is still
+	// too long keeps only its innermost and outermost lines.
+	var lines []string
+	var calls []int // how many frames each line stands for
+	for i := 0; i < len(e.Stack); {
+		run := 1
+		for i+run < len(e.Stack) && e.Stack[i+run] == e.Stack[i] {
+			run++
+		}
+		lines = append(lines, e.Stack[i].String())
+		calls = append(calls, 1)
+		if run > 1 {
+			lines = append(lines, fmt.Sprintf("... repeated %d more times", run-1))
+			calls = append(calls, run-1)
+		}
+		i += run
 	}
-	return "Error: " + e.Message
+	if len(lines) > maxTraceLines {
+		keep := maxTraceLines / 2
+		omitted := 0
+		for _, n := range calls[keep : len(lines)-keep] {
+			omitted += n
+		}
+		lines = append(append(lines[:keep:keep], fmt.Sprintf("... %d more calls", omitted)), lines[len(lines)-keep:]...)
+	}
+	for _, line := range lines {
+		out.WriteString("\n  " + line)
+	}
+	return out.String()
+}
+
+// maxTraceLines caps how many lines of stack trace an error prints, so
+// runaway mutual recursion doesn't print thousands of them.
+const maxTraceLines = 20
+
+// Call is a function call in progress. Each call links to the call it was
+// made from, so the chain of calls can be walked for a stack trace.
+type Call struct {
+	Function string
+	File     string // File of the function's code
+	Line     int    // Line of the call site, in the caller's code
+	Column   int    // Column of the call site
+	Caller   *Call  // Call the call was made from (nil at the top level)
+	Depth    int    // Number of calls in the chain, this one included
+}
+
+// Frame is one entry in an error's stack trace: a function call that was in
+// progress, and where that function had got to. That is the error itself
+// for the innermost call, and the next call in for the others.
+type Frame struct {
+	Function string
+	Line     int    // Line reached in the function
+	Column   int    // Column reached in the function
+	File     string // File of the function's code (empty string if not from file)
+}
+
+func (f Frame) String() string {
+	if f.File != "" {
+		return fmt.Sprintf("at %s (%s:%d)", f.Function, f.File, f.Line)
+	}
+	return fmt.Sprintf("at %s (line %d)", f.Function, f.Line)
+}
+
+// ErrorValue is an Error held as an ordinary value: caught by try/catch and
+// bound to a variable, or built with the errors module.
+// Wrapping keeps the caught error from unwinding evaluation again: only
+// smite turns it back into an Error. Programs read it through its members.
+type ErrorValue struct {
+	Err *Error
+}
+
+func (ev *ErrorValue) Type() string {
+	return "ERROR_VALUE"
+}
+
+func (ev *ErrorValue) Inspect() string {
+	return ev.Err.Inspect()
+}
+
+// Get returns a member of the caught error: message, line, column or cause.
+func (ev *ErrorValue) Get(name string) (Object, bool) {
+	switch name {
+	case "message":
+		return &String{Value: ev.Err.Message}, true
+	case "line":
+		return &Integer{Value: int64(ev.Err.Line)}, true
+	case "column":
+		return &Integer{Value: int64(ev.Err.Column)}, true
+	case "cause":
+		if ev.Err.Cause == nil {
+			return NULL, true
+		}
+		return &ErrorValue{Err: ev.Err.Cause}, true
+	}
+	return nil, false
+}
+
+// MemberNames returns the names Get understands, sorted.
+func (ev *ErrorValue) MemberNames() []string {
+	return []string{"cause", "column", "line", "message"}
 }
@@ -1,8 +1,11 @@
 package object
 
 import (
+	"math"
+	"strings"
 	"testing"
 
+	"github.com/elitwilson/beeflang/internal/ast"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,9 +14,13 @@ import (
 func TestObjectInterface(t *testing.T) {
 	// Verify all types implement the Object interface
 	var _ Object = &Integer{}
+	var _ Object = &Float{}
 	var _ Object = &Boolean{}
 	var _ Object = &String{}
 	var _ Object = &Null{}
+	var _ Object = &Array{}
+	var _ Object = &Hash{}
+	var _ Object = &Range{}
 	var _ Object = &Module{}
 	var _ Object = &Builtin{}
 }
@@ -25,6 +32,24 @@ func TestIntegerTypeAndInspect(t *testing.T) {
 	assert.Equal(t, "42", integer.Inspect())
 }
 
+func TestFloatTypeAndInspect(t *testing.T) {
+	tests := []struct {
+		value    float64
+		expected string
+	}{
+		{3.14, "3.14"},
+		{2, "2.0"},
+		{-0.5, "-0.5"},
+		{100, "100.0"},
+	}
+
+	for _, tt := range tests {
+		f := &Float{Value: tt.value}
+		assert.Equal(t, "FLOAT", f.Type())
+		assert.Equal(t, tt.expected, f.Inspect())
+	}
+}
+
 func TestBooleanTypeAndInspect(t *testing.T) {
 	trueVal := &Boolean{Value: true}
 	falseVal := &Boolean{Value: false}
@@ -43,6 +68,13 @@ func TestStringTypeAndInspect(t *testing.T) {
 	assert.Equal(t, "Hello, Beef!", str.Inspect())
 }
 
+func TestStringLenCountsCharacters(t *testing.T) {
+	assert.Equal(t, int64(4), (&String{Value: "beef"}).Len())
+	assert.Equal(t, int64(4), (&String{Value: "café"}).Len())
+	assert.Equal(t, int64(1), (&String{Value: "🥩"}).Len())
+	assert.Equal(t, int64(0), (&String{Value: ""}).Len())
+}
+
 func TestNullTypeAndInspect(t *testing.T) {
 	null := &Null{}
 
@@ -50,6 +82,157 @@ func TestNullTypeAndInspect(t *testing.T) {
 	assert.Equal(t, "null", null.Inspect())
 }
 
+func TestArrayTypeAndInspect(t *testing.T) {
+	array := &Array{Elements: []Object{
+		&Integer{Value: 1},
+		&String{Value: "beef"},
+		TRUE,
+		&Array{Elements: []Object{&Integer{Value: 2}}},
+	}}
+
+	assert.Equal(t, "ARRAY", array.Type())
+	assert.Equal(t, "[1, beef, true, [2]]", array.Inspect())
+
+	empty := &Array{Elements: []Object{}}
+	assert.Equal(t, "[]", empty.Inspect())
+}
+
+func TestHashKeys(t *testing.T) {
+	// Equal values produce equal keys, even from different objects
+	assert.Equal(t, (&String{Value: "beef"}).HashKey(), (&String{Value: "beef"}).HashKey())
+	assert.Equal(t, (&Integer{Value: 7}).HashKey(), (&Integer{Value: 7}).HashKey())
+	assert.Equal(t, TRUE.HashKey(), (&Boolean{Value: true}).HashKey())
+
+	// Different values or types produce different keys
+	assert.NotEqual(t, (&String{Value: "beef"}).HashKey(), (&String{Value: "pork"}).HashKey())
+	assert.NotEqual(t, (&String{Value: "1"}).HashKey(), (&Integer{Value: 1}).HashKey())
+	assert.NotEqual(t, (&String{Value: "true"}).HashKey(), TRUE.HashKey())
+}
+
+func TestHashTypeAndInspect(t *testing.T) {
+	hash := NewHash()
+	hash.Set(&String{Value: "name"}, &String{Value: "Beef"})
+	hash.Set(&Integer{Value: 1}, TRUE)
+
+	assert.Equal(t, "HASH", hash.Type())
+	assert.Equal(t, "{name: Beef, 1: true}", hash.Inspect())
+	assert.Equal(t, "{}", NewHash().Inspect())
+}
+
+func TestInspectSelfReferencingContainers(t *testing.T) {
+	array := &Array{Elements: []Object{&Integer{Value: 1}}}
+	array.Elements = append(array.Elements, array)
+	assert.Equal(t, "[1, [...]]", array.Inspect())
+
+	hash := NewHash()
+	hash.Set(&String{Value: "self"}, hash)
+	hash.Set(&String{Value: "list"}, &Array{Elements: []Object{hash}})
+	assert.Equal(t, "{self: {...}, list: [{...}]}", hash.Inspect())
+
+	node := &Instance{Struct: &StructType{Name: "Node", Fields: []string{"next"}}, Fields: map[string]Object{}}
+	node.Fields["next"] = node
+	assert.Equal(t, "Node(next: Node(...))", node.Inspect())
+
+	// A value that appears twice without a cycle is printed in full each time
+	shared := &Array{Elements: []Object{&Integer{Value: 2}}}
+	twice := &Array{Elements: []Object{shared, shared}}
+	assert.Equal(t, "[[2], [2]]", twice.Inspect())
+}
+
+func TestHashGetAndSet(t *testing.T) {
+	hash := NewHash()
+	hash.Set(&String{Value: "a"}, &Integer{Value: 1})
+	hash.Set(&String{Value: "b"}, &Integer{Value: 2})
+
+	// Overwriting keeps the original insertion position
+	hash.Set(&String{Value: "a"}, &Integer{Value: 3})
+
+	val, ok := hash.Get(&String{Value: "a"})
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), val.(*Integer).Value)
+
+	_, ok = hash.Get(&String{Value: "missing"})
+	assert.False(t, ok)
+
+	entries := hash.Entries()
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "a", entries[0].Key.Inspect())
+	assert.Equal(t, "b", entries[1].Key.Inspect())
+}
+
+func TestRangeTypeAndInspect(t *testing.T) {
+	r := &Range{Start: 0, End: 10, Step: 1}
+	assert.Equal(t, "RANGE", r.Type())
+	assert.Equal(t, "range(0, 10)", r.Inspect())
+
+	stepped := &Range{Start: 10, End: 0, Step: -2}
+	assert.Equal(t, "range(10, 0, -2)", stepped.Inspect())
+}
+
+func TestRangeLen(t *testing.T) {
+	tests := []struct {
+		r        *Range
+		expected int64
+	}{
+		{&Range{Start: 0, End: 10, Step: 1}, 10},
+		{&Range{Start: 0, End: 10, Step: 3}, 4},
+		{&Range{Start: 10, End: 0, Step: -1}, 10},
+		{&Range{Start: 10, End: 0, Step: -3}, 4},
+		{&Range{Start: 5, End: 5, Step: 1}, 0},
+		{&Range{Start: 5, End: 0, Step: 1}, 0},
+		// Lengths near the int64 limits don't overflow
+		{&Range{Start: 0, End: math.MaxInt64, Step: 2}, math.MaxInt64/2 + 1},
+		{&Range{Start: math.MaxInt64 - 1, End: math.MaxInt64, Step: 5}, 1},
+		{&Range{Start: math.MinInt64, End: math.MaxInt64, Step: math.MaxInt64}, 3},
+		{&Range{Start: math.MaxInt64, End: math.MinInt64, Step: math.MinInt64}, 2},
+		{&Range{Start: math.MinInt64, End: math.MaxInt64, Step: 1}, math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.r.Len(), "Range: %s", tt.r.Inspect())
+	}
+}
+
+// collect drains an iterator into a slice of inspected values
+func collect(it Iterable) []string {
+	values := []string{}
+	iter := it.Iterator()
+	for {
+		val, ok := iter.Next()
+		if !ok {
+			return values
+		}
+		values = append(values, val.Inspect())
+	}
+}
+
+func TestIterables(t *testing.T) {
+	hash := NewHash()
+	hash.Set(&String{Value: "b"}, &Integer{Value: 1})
+	hash.Set(&String{Value: "a"}, &Integer{Value: 2})
+
+	tests := []struct {
+		iterable Iterable
+		expected []string
+	}{
+		{&Range{Start: 0, End: 3, Step: 1}, []string{"0", "1", "2"}},
+		{&Range{Start: 3, End: 0, Step: -1}, []string{"3", "2", "1"}},
+		{&Range{Start: 0, End: 0, Step: 1}, []string{}},
+		{&Range{Start: 0, End: 10, Step: 5}, []string{"0", "5"}},
+		// Ranges ending near the int64 limits stop instead of wrapping around
+		{&Range{Start: math.MaxInt64 - 1, End: math.MaxInt64, Step: 5}, []string{"9223372036854775806"}},
+		{&Range{Start: math.MaxInt64 - 3, End: math.MaxInt64, Step: 2}, []string{"9223372036854775804", "9223372036854775806"}},
+		{&Range{Start: math.MinInt64 + 1, End: math.MinInt64, Step: -3}, []string{"-9223372036854775807"}},
+		{&Array{Elements: []Object{&Integer{Value: 7}, TRUE}}, []string{"7", "true"}},
+		{&String{Value: "beef"}, []string{"b", "e", "e", "f"}},
+		{hash, []string{"b", "a"}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, collect(tt.iterable), "Iterable: %s", tt.iterable.Inspect())
+	}
+}
+
 func TestIntegerValue(t *testing.T) {
 	tests := []struct {
 		value    int64
@@ -91,6 +274,16 @@ func TestStringValue(t *testing.T) {
 	}
 }
 
+func TestLoopSignalTypes(t *testing.T) {
+	var _ Object = BREAK
+	var _ Object = CONTINUE
+
+	assert.Equal(t, "BREAK_SIGNAL", BREAK.Type())
+	assert.Equal(t, "CONTINUE_SIGNAL", CONTINUE.Type())
+	assert.Equal(t, "sacrifice", BREAK.Inspect())
+	assert.Equal(t, "repent", CONTINUE.Inspect())
+}
+
 func TestNullIsUnique(t *testing.T) {
 	// Create a global NULL instance for efficiency
 	// All null values should reference the same instance
@@ -219,6 +412,129 @@ func TestErrorObjectInspectWithFile(t *testing.T) {
 	assert.Equal(t, "Error at examples/test.beef:12:5 - type mismatch", err.Inspect())
 }
 
+func TestErrorObjectInspectWithStack(t *testing.T) {
+	err := &Error{
+		Message: "division by zero",
+		Line:    10,
+		Column:  12,
+		Stack: []Frame{
+			{Function: "fibonacci", Line: 10, Column: 12},
+			{Function: "main", Line: 5, Column: 3, File: "examples/test.beef"},
+		},
+	}
+	expected := "Error at line 10, column 12 - division by zero\n" +
+		"  at fibonacci (line 10)\n" +
+		"  at main (examples/test.beef:5)"
+	assert.Equal(t, expected, err.Inspect())
+}
+
+func TestErrorObjectInspectCollapsesRecursion(t *testing.T) {
+	recursive := Frame{Function: "factorial", Line: 9, Column: 15}
+	err := &Error{
+		Message: "division by zero",
+		Line:    6,
+		Column:  15,
+		Stack: []Frame{
+			{Function: "factorial", Line: 6, Column: 15},
+			recursive, recursive, recursive, recursive,
+			{Function: "ChurchOfBeef", Line: 16, Column: 26},
+		},
+	}
+	expected := "Error at line 6, column 15 - division by zero\n" +
+		"  at factorial (line 6)\n" +
+		"  at factorial (line 9)\n" +
+		"  ... repeated 3 more times\n" +
+		"  at ChurchOfBeef (line 16)"
+	assert.Equal(t, expected, err.Inspect())
+}
+
+func TestErrorObjectInspectShortensLongTrace(t *testing.T) {
+	// Mutual recursion: frames alternate, so nothing collapses
+	err := &Error{Message: "too deep"}
+	for i := 0; i < 100; i++ {
+		err.Stack = append(err.Stack, Frame{Function: []string{"ping", "pong"}[i%2], Line: i%2 + 1})
+	}
+
+	lines := strings.Split(err.Inspect(), "\n")
+	assert.Len(t, lines, maxTraceLines+2)
+	assert.Equal(t, "  at ping (line 1)", lines[1])
+	assert.Equal(t, "  ... 80 more calls", lines[maxTraceLines/2+1])
+	assert.Equal(t, "  at pong (line 2)", lines[len(lines)-1])
+}
+
 func TestErrorImplementsObjectInterface(t *testing.T) {
 	var _ Object = &Error{}
 }
+
+func TestErrorValueWrapsError(t *testing.T) {
+	err := &Error{Message: "type mismatch", Line: 3, Column: 7}
+	val := &ErrorValue{Err: err}
+
+	assert.Equal(t, "ERROR_VALUE", val.Type(), "a caught error must not look like an ERROR")
+	assert.Equal(t, "Error at line 3, column 7 - type mismatch", val.Inspect())
+
+	message, ok := val.Get("message")
+	assert.True(t, ok)
+	assert.Equal(t, "type mismatch", message.(*String).Value)
+
+	line, ok := val.Get("line")
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), line.(*Integer).Value)
+
+	column, ok := val.Get("column")
+	assert.True(t, ok)
+	assert.Equal(t, int64(7), column.(*Integer).Value)
+
+	_, ok = val.Get("stack")
+	assert.False(t, ok)
+}
+
+func TestInstanceInspectAndMembers(t *testing.T) {
+	describe := &Function{}
+	steak := &StructType{
+		Name:    "Steak",
+		Fields:  []string{"cut", "weight"},
+		Methods: map[string]*Function{"describe": describe},
+	}
+	instance := &Instance{
+		Struct: steak,
+		Fields: map[string]Object{
+			"cut":    &String{Value: "ribeye"},
+			"weight": &Integer{Value: 12},
+		},
+	}
+
+	assert.Equal(t, "CONGREGATION", steak.Type())
+	assert.Equal(t, "<congregation Steak>", steak.Inspect())
+	assert.Equal(t, "INSTANCE", instance.Type())
+	assert.Equal(t, "Steak(cut: ribeye, weight: 12)", instance.Inspect())
+
+	weight, ok := instance.Get("weight")
+	assert.True(t, ok)
+	assert.Equal(t, int64(12), weight.(*Integer).Value)
+
+	method, ok := instance.Get("describe")
+	assert.True(t, ok)
+	bound, ok := method.(*BoundMethod)
+	assert.True(t, ok, "methods are returned bound to the instance")
+	assert.Same(t, instance, bound.Receiver)
+	assert.Same(t, describe, bound.Method)
+
+	_, ok = instance.Get("missing")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"cut", "describe", "weight"}, instance.MemberNames())
+}
+
+func TestFunctionRequiredParameters(t *testing.T) {
+	param := func(name string) *ast.Identifier { return &ast.Identifier{Value: name} }
+	fn := &Function{
+		Parameters: []*ast.Identifier{param("name"), param("greeting"), param("times")},
+		Defaults:   []ast.Expression{nil, &ast.StringLiteral{Value: "Braised be"}, &ast.IntegerLiteral{Value: 1}},
+	}
+	assert.Equal(t, 1, fn.RequiredParameters())
+
+	// Functions built without defaults require every parameter
+	plain := &Function{Parameters: []*ast.Identifier{param("a"), param("b")}}
+	assert.Equal(t, 2, plain.RequiredParameters())
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"fmt"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/elitwilson/beeflang/internal/ast"
 	"github.com/elitwilson/beeflang/internal/lexer"
@@ -15,16 +16,24 @@ import (
 const (
 	_ int = iota
 	LOWEST
+	LOGICAL_OR  // or, ||
+	LOGICAL_AND // and, &&
+	LOGICAL_NOT // not X (binds looser than comparisons: not a == b is not (a == b))
 	EQUALS      // ==
 	LESSGREATER // > or <
 	SUM         // +
 	PRODUCT     // *
 	PREFIX      // -X or !X
 	CALL        // myFunction(X)
+	INDEX       // array[index]
 	MEMBER      // object.member
 )
 
 var precedences = map[token.TokenType]int{
+	token.OR:       LOGICAL_OR,
+	token.OR_WORD:  LOGICAL_OR,
+	token.AND:      LOGICAL_AND,
+	token.AND_WORD: LOGICAL_AND,
 	token.EQ:       EQUALS,
 	token.NOT_EQ:   EQUALS,
 	token.LT:       LESSGREATER,
@@ -37,6 +46,7 @@ var precedences = map[token.TokenType]int{
 	token.ASTERISK: PRODUCT,
 	token.PERCENT:  PRODUCT,
 	token.LPAREN:   CALL,
+	token.LBRACKET: INDEX,
 	token.DOT:      MEMBER,
 }
 
@@ -56,6 +66,15 @@ type Parser struct {
 
 	prefixParseFns map[token.TokenType]prefixParseFn
 	infixParseFns  map[token.TokenType]infixParseFn
+
+	// loopDepth counts the loops enclosing the current statement within the
+	// current function, so sacrifice/repent outside a loop is a parse error
+	loopDepth int
+
+	// scopes tracks the names declared in each enclosing block (true for
+	// pack constants), so obvious reassignments of a constant are caught
+	// while parsing instead of at runtime
+	scopes []map[string]bool
 }
 
 type (
@@ -71,17 +90,26 @@ func New(l *lexer.Lexer) *Parser {
 	p := &Parser{
 		l:      l,
 		errors: []string{},
+		scopes: []map[string]bool{{}},
 	}
 
 	// Register prefix parse functions
 	p.prefixParseFns = make(map[token.TokenType]prefixParseFn)
 	p.registerPrefix(token.IDENT, p.parseIdentifier)
 	p.registerPrefix(token.INT, p.parseIntegerLiteral)
+	p.registerPrefix(token.FLOAT, p.parseFloatLiteral)
 	p.registerPrefix(token.TRUE, p.parseBooleanLiteral)
 	p.registerPrefix(token.FALSE, p.parseBooleanLiteral)
 	p.registerPrefix(token.STRING, p.parseStringLiteral)
+	p.registerPrefix(token.INTERPOLATED, p.parseInterpolatedString)
 	p.registerPrefix(token.MINUS, p.parsePrefixExpression)
 	p.registerPrefix(token.NOT, p.parsePrefixExpression)
+	p.registerPrefix(token.NOT_WORD, p.parseNotExpression)
+	p.registerPrefix(token.LPAREN, p.parseGroupedExpression)
+	p.registerPrefix(token.PRAISE, p.parseFunctionLiteral)
+	p.registerPrefix(token.LBRACKET, p.parseArrayLiteral)
+	p.registerPrefix(token.LBRACE, p.parseHashLiteral)
+	p.registerPrefix(token.ILLEGAL, p.parseIllegal)
 
 	// Register infix parse functions
 	p.infixParseFns = make(map[token.TokenType]infixParseFn)
@@ -96,8 +124,13 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerInfix(token.GT, p.parseInfixExpression)
 	p.registerInfix(token.LTE, p.parseInfixExpression)
 	p.registerInfix(token.GTE, p.parseInfixExpression)
+	p.registerInfix(token.AND, p.parseInfixExpression)
+	p.registerInfix(token.OR, p.parseInfixExpression)
+	p.registerInfix(token.AND_WORD, p.parseInfixExpression)
+	p.registerInfix(token.OR_WORD, p.parseInfixExpression)
 	p.registerInfix(token.LPAREN, p.parseFunctionCall)
 	p.registerInfix(token.DOT, p.parseMemberAccessExpression)
+	p.registerInfix(token.LBRACKET, p.parseIndexExpression)
 
 	// Read two tokens to initialize curToken and peekToken
 	p.nextToken()
@@ -131,16 +164,39 @@ func (p *Parser) parseStatement() ast.Statement {
 	switch p.curToken.Type {
 	case token.PREP:
 		return p.parseVariableDeclaration()
+	case token.PACK:
+		return p.parseConstantDeclaration()
 	case token.SERVE:
 		return p.parseReturnStatement()
 	case token.IF:
 		return p.parseIfStatement()
 	case token.PRAISE:
+		// praise(...) without a name is an anonymous function expression
+		if p.peekTokenIs(token.LPAREN) {
+			return p.parseExpressionStatement()
+		}
 		return p.parseFunctionDeclaration()
 	case token.FEAST_WHILE:
+		if p.peekTokenIs(token.FOR) {
+			return p.parseForLoop()
+		}
 		return p.parseWhileLoop()
+	case token.FOR:
+		return p.parseForLoop()
 	case token.WRANGLE:
 		return p.parseWrangleStatement()
+	case token.HERD:
+		return p.parseHerdStatement()
+	case token.SACRIFICE:
+		return p.parseBreakStatement()
+	case token.REPENT:
+		return p.parseContinueStatement()
+	case token.TRY:
+		return p.parseTryStatement()
+	case token.SMITE:
+		return p.parseSmiteStatement()
+	case token.CONGREGATION:
+		return p.parseStructDeclaration()
 	case token.IDENT:
 		// Check if this is an assignment (x = value) or expression statement
 		if p.peekTokenIs(token.ASSIGN) {
@@ -168,6 +224,28 @@ func (p *Parser) parseVariableDeclaration() *ast.VariableDeclaration {
 	p.nextToken()
 
 	stmt.Value = p.parseExpression(LOWEST)
+	p.declareBinding(stmt.Name, false)
+
+	return stmt
+}
+
+func (p *Parser) parseConstantDeclaration() *ast.ConstantDeclaration {
+	stmt := &ast.ConstantDeclaration{Token: p.curToken}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	if !p.expectPeek(token.ASSIGN) {
+		return nil
+	}
+
+	p.nextToken()
+
+	stmt.Value = p.parseExpression(LOWEST)
+	p.declareBinding(stmt.Name, true)
 
 	return stmt
 }
@@ -194,8 +272,24 @@ func (p *Parser) parseIfStatement() *ast.IfStatement {
 
 	stmt.Consequence = p.parseBlockStatement()
 
-	// Optional else block
+	// Optional else-if chain
 	// After parseBlockStatement(), we're sitting on the terminator (either ELSE or BEEF)
+	for p.curTokenIs(token.ELSE) && p.peekTokenIs(token.IF) {
+		p.nextToken()
+		clause := &ast.ElseIfClause{Token: p.curToken}
+
+		p.nextToken()
+		clause.Condition = p.parseExpression(LOWEST)
+
+		if !p.expectPeek(token.COLON) {
+			return nil
+		}
+
+		clause.Consequence = p.parseBlockStatement()
+		stmt.ElseIfs = append(stmt.ElseIfs, clause)
+	}
+
+	// Optional else block
 	if p.curTokenIs(token.ELSE) {
 		if !p.expectPeek(token.COLON) {
 			return nil
@@ -215,40 +309,144 @@ func (p *Parser) parseFunctionDeclaration() *ast.FunctionDeclaration {
 	}
 
 	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	p.declareBinding(stmt.Name, false)
 
 	if !p.expectPeek(token.LPAREN) {
 		return nil
 	}
 
-	stmt.Parameters = p.parseFunctionParameters()
+	stmt.Parameters, stmt.Defaults, stmt.Rest = p.parseFunctionParameters()
 
 	if !p.expectPeek(token.COLON) {
 		return nil
 	}
 
-	stmt.Body = p.parseBlockStatement()
+	stmt.Body = p.parseFunctionBody(stmt.Parameters, stmt.Rest)
 
 	return stmt
 }
 
-func (p *Parser) parseFunctionParameters() []*ast.Identifier {
+// parseFunctionLiteral parses an anonymous function: praise(x): serve x * 2 beef
+func (p *Parser) parseFunctionLiteral() ast.Expression {
+	lit := &ast.FunctionLiteral{Token: p.curToken}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	lit.Parameters, lit.Defaults, lit.Rest = p.parseFunctionParameters()
+
+	if !p.expectPeek(token.COLON) {
+		return nil
+	}
+
+	lit.Body = p.parseFunctionBody(lit.Parameters, lit.Rest)
+
+	return lit
+}
+
+// parseFunctionBody parses the block after a function header's colon.
+// A function body starts outside of any loop, even if the function
+// itself is declared inside one. Parameters are ordinary variables that
+// may shadow outer constants.
+func (p *Parser) parseFunctionBody(params []*ast.Identifier, rest *ast.Identifier) *ast.BlockStatement {
+	outerLoopDepth := p.loopDepth
+	p.loopDepth = 0
+	p.pushScope()
+	for _, param := range params {
+		p.declare(param.Value, false)
+	}
+	if rest != nil {
+		p.declare(rest.Value, false)
+	}
+	body := p.parseBlockStatement()
+	p.popScope()
+	p.loopDepth = outerLoopDepth
+	return body
+}
+
+// parseFunctionParameters parses a parameter list such as (name, greeting = "Braised be").
+// It returns the parameters and, in parallel, their default expressions (nil when
+// there is none). Once a parameter has a default, every later one needs one too.
+// A final ...name rest parameter is returned separately (nil when absent).
+func (p *Parser) parseFunctionParameters() ([]*ast.Identifier, []ast.Expression, *ast.Identifier) {
 	identifiers := []*ast.Identifier{}
+	defaults := []ast.Expression{}
 
 	if p.peekTokenIs(token.RPAREN) {
 		p.nextToken()
-		return identifiers
+		return identifiers, defaults, nil
 	}
 
-	p.nextToken()
+	for {
+		if p.peekTokenIs(token.ELLIPSIS) {
+			p.nextToken()
+			if !p.expectPeek(token.IDENT) {
+				return nil, nil, nil
+			}
+			rest := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+			if !p.peekTokenIs(token.RPAREN) {
+				msg := fmt.Sprintf("[line %d, col %d] rest parameter ...%s must be the last parameter",
+					rest.Token.Line, rest.Token.Column, rest.Value)
+				p.errors = append(p.errors, msg)
+				return nil, nil, nil
+			}
+			p.nextToken()
+			return identifiers, defaults, rest
+		}
 
-	ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-	identifiers = append(identifiers, ident)
+		if !p.expectPeek(token.IDENT) {
+			return nil, nil, nil
+		}
+		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
 
-	for p.peekTokenIs(token.COMMA) {
+		var def ast.Expression
+		if p.peekTokenIs(token.ASSIGN) {
+			p.nextToken()
+			p.nextToken()
+			def = p.parseExpression(LOWEST)
+		} else if len(defaults) > 0 && defaults[len(defaults)-1] != nil {
+			msg := fmt.Sprintf("[line %d, col %d] parameter %s without a default cannot follow parameters with defaults",
+				ident.Token.Line, ident.Token.Column, ident.Value)
+			p.errors = append(p.errors, msg)
+		}
+
+		identifiers = append(identifiers, ident)
+		defaults = append(defaults, def)
+
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
 		p.nextToken()
+	}
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil, nil, nil
+	}
+
+	return identifiers, defaults, nil
+}
+
+// parseIdentifierList parses a parenthesized list of plain names, such as the
+// exports of a herd or the fields of a congregation: (a, b, c)
+func (p *Parser) parseIdentifierList() []*ast.Identifier {
+	identifiers := []*ast.Identifier{}
+
+	if p.peekTokenIs(token.RPAREN) {
+		p.nextToken()
+		return identifiers
+	}
+
+	for {
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+		identifiers = append(identifiers, &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal})
+
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
 		p.nextToken()
-		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-		identifiers = append(identifiers, ident)
 	}
 
 	if !p.expectPeek(token.RPAREN) {
@@ -262,10 +460,14 @@ func (p *Parser) parseBlockStatement() *ast.BlockStatement {
 	block := &ast.BlockStatement{Token: p.curToken}
 	block.Statements = []ast.Statement{}
 
+	p.pushScope()
+	defer p.popScope()
+
 	p.nextToken()
 
-	// Stop at beef (end of block), else (if in consequence of if statement), or EOF
-	for !p.curTokenIs(token.BEEF) && !p.curTokenIs(token.ELSE) && !p.curTokenIs(token.EOF) {
+	// Stop at beef (end of block), else (if in consequence of if statement),
+	// catch (end of a try body), or EOF
+	for !p.curTokenIs(token.BEEF) && !p.curTokenIs(token.ELSE) && !p.curTokenIs(token.CATCH) && !p.curTokenIs(token.EOF) {
 		stmt := p.parseStatement()
 		if stmt != nil {
 			block.Statements = append(block.Statements, stmt)
@@ -279,6 +481,17 @@ func (p *Parser) parseBlockStatement() *ast.BlockStatement {
 func (p *Parser) parseExpressionStatement() ast.Statement {
 	stmt := &ast.ExpressionStatement{Token: p.curToken}
 	stmt.Expression = p.parseExpression(LOWEST)
+
+	// An index expression followed by '=' is an element assignment: arr[0] = 99
+	if index, ok := stmt.Expression.(*ast.IndexExpression); ok && p.peekTokenIs(token.ASSIGN) {
+		return p.parseIndexAssignmentStatement(index)
+	}
+
+	// Likewise a member access followed by '=' is a field assignment: steak.weight = 12
+	if member, ok := stmt.Expression.(*ast.MemberAccessExpression); ok && p.peekTokenIs(token.ASSIGN) {
+		return p.parseMemberAssignmentStatement(member)
+	}
+
 	return stmt
 }
 
@@ -296,6 +509,12 @@ func (p *Parser) parseExpression(precedence int) ast.Expression {
 			return leftExp
 		}
 
+		// Statements are newline-terminated, so a '[' or '(' at the start of a new
+		// line begins a new expression rather than indexing or calling the previous one
+		if (p.peekTokenIs(token.LBRACKET) || p.peekTokenIs(token.LPAREN)) && p.peekToken.Line > p.curToken.Line {
+			return leftExp
+		}
+
 		p.nextToken()
 
 		leftExp = infix(leftExp)
@@ -322,6 +541,20 @@ func (p *Parser) parseIntegerLiteral() ast.Expression {
 	return lit
 }
 
+func (p *Parser) parseFloatLiteral() ast.Expression {
+	lit := &ast.FloatLiteral{Token: p.curToken}
+
+	value, err := strconv.ParseFloat(p.curToken.Literal, 64)
+	if err != nil {
+		msg := fmt.Sprintf("could not parse %q as float", p.curToken.Literal)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	lit.Value = value
+	return lit
+}
+
 func (p *Parser) parseBooleanLiteral() ast.Expression {
 	return &ast.BooleanLiteral{
 		Token: p.curToken,
@@ -336,6 +569,35 @@ func (p *Parser) parseStringLiteral() ast.Expression {
 	}
 }
 
+// parseInterpolatedString splits a string like "total: {sum + 1}" into its text
+// and expression parts. Each embedded expression is lexed at its real position
+// in the file and parsed by a sub-parser, whose errors are merged into ours.
+func (p *Parser) parseInterpolatedString() ast.Expression {
+	str := &ast.InterpolatedString{Token: p.curToken}
+
+	segments := lexer.SplitInterpolation(p.curToken.Literal, p.curToken.Line, p.curToken.Column)
+	for _, seg := range segments {
+		if !seg.IsExpr {
+			tok := token.Token{Type: token.STRING, Literal: seg.Text, Line: seg.Line, Column: seg.Column}
+			str.Parts = append(str.Parts, &ast.StringLiteral{Token: tok, Value: seg.Text})
+			continue
+		}
+
+		sub := New(lexer.NewAt(seg.Text, seg.Line, seg.Column))
+		expr := sub.parseExpression(LOWEST)
+		if len(sub.errors) == 0 && !sub.peekTokenIs(token.EOF) {
+			sub.nextToken()
+			msg := fmt.Sprintf("[line %d, col %d] unexpected %s in string interpolation",
+				sub.curToken.Line, sub.curToken.Column, sub.curToken.Literal)
+			sub.errors = append(sub.errors, msg)
+		}
+		p.errors = append(p.errors, sub.errors...)
+		str.Parts = append(str.Parts, expr)
+	}
+
+	return str
+}
+
 func (p *Parser) parsePrefixExpression() ast.Expression {
 	expression := &ast.PrefixExpression{
 		Token:    p.curToken,
@@ -349,6 +611,33 @@ func (p *Parser) parsePrefixExpression() ast.Expression {
 	return expression
 }
 
+// parseNotExpression parses the 'not' keyword. Unlike '!', it binds looser than
+// comparisons, so "not x == 5" means "not (x == 5)" as in Python.
+func (p *Parser) parseNotExpression() ast.Expression {
+	expression := &ast.PrefixExpression{
+		Token:    p.curToken,
+		Operator: p.curToken.Literal,
+	}
+
+	p.nextToken()
+
+	expression.Right = p.parseExpression(LOGICAL_NOT)
+
+	return expression
+}
+
+func (p *Parser) parseGroupedExpression() ast.Expression {
+	p.nextToken()
+
+	exp := p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	return exp
+}
+
 func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 	expression := &ast.InfixExpression{
 		Token:    p.curToken,
@@ -365,32 +654,57 @@ func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 
 func (p *Parser) parseFunctionCall(function ast.Expression) ast.Expression {
 	exp := &ast.FunctionCall{Token: p.curToken, Function: function}
-	exp.Arguments = p.parseCallArguments()
+	exp.Arguments, exp.Keywords = p.parseCallArguments()
 	return exp
 }
 
-func (p *Parser) parseCallArguments() []ast.Expression {
+// parseCallArguments parses positional arguments followed by keyword
+// arguments: connect("db", host = "x", port = 1)
+func (p *Parser) parseCallArguments() ([]ast.Expression, []*ast.KeywordArgument) {
 	args := []ast.Expression{}
+	keywords := []*ast.KeywordArgument{}
 
 	if p.peekTokenIs(token.RPAREN) {
 		p.nextToken()
-		return args
+		return args, keywords
 	}
 
-	p.nextToken()
-	args = append(args, p.parseExpression(LOWEST))
-
-	for p.peekTokenIs(token.COMMA) {
+	seen := make(map[string]bool)
+	for {
 		p.nextToken()
+
+		if p.curTokenIs(token.IDENT) && p.peekTokenIs(token.ASSIGN) {
+			kw := &ast.KeywordArgument{Token: p.curToken, Name: &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}}
+			if seen[kw.Name.Value] {
+				msg := fmt.Sprintf("[line %d, col %d] duplicate keyword argument %s",
+					kw.Token.Line, kw.Token.Column, kw.Name.Value)
+				p.errors = append(p.errors, msg)
+			}
+			seen[kw.Name.Value] = true
+			p.nextToken()
+			p.nextToken()
+			kw.Value = p.parseExpression(LOWEST)
+			keywords = append(keywords, kw)
+		} else {
+			if len(keywords) > 0 {
+				msg := fmt.Sprintf("[line %d, col %d] positional argument cannot follow keyword arguments",
+					p.curToken.Line, p.curToken.Column)
+				p.errors = append(p.errors, msg)
+			}
+			args = append(args, p.parseExpression(LOWEST))
+		}
+
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
 		p.nextToken()
-		args = append(args, p.parseExpression(LOWEST))
 	}
 
 	if !p.expectPeek(token.RPAREN) {
-		return nil
+		return nil, nil
 	}
 
-	return args
+	return args, keywords
 }
 
 // Helper methods
@@ -423,6 +737,19 @@ func (p *Parser) peekError(t token.TokenType) {
 	p.errors = append(p.errors, msg)
 }
 
+// parseIllegal reports a token the lexer could not make sense of.
+// A single character is shown as is; malformed string literals carry a
+// description of the problem as their literal.
+func (p *Parser) parseIllegal() ast.Expression {
+	desc := p.curToken.Literal
+	if utf8.RuneCountInString(desc) == 1 {
+		desc = fmt.Sprintf("illegal character %q", desc)
+	}
+	msg := fmt.Sprintf("[line %d, col %d] %s", p.curToken.Line, p.curToken.Column, desc)
+	p.errors = append(p.errors, msg)
+	return nil
+}
+
 func (p *Parser) noPrefixParseFnError(t token.TokenType) {
 	msg := fmt.Sprintf("[line %d, col %d] no prefix parse function for %s found",
 		p.curToken.Line, p.curToken.Column, t)
@@ -462,6 +789,13 @@ func (p *Parser) parseAssignmentStatement() *ast.AssignmentStatement {
 	p.nextToken()
 	stmt.Value = p.parseExpression(LOWEST)
 
+	if p.isConstant(stmt.Name.Value) {
+		msg := fmt.Sprintf("[line %d, col %d] cannot reassign constant %s",
+			stmt.Token.Line, stmt.Token.Column, stmt.Name.Value)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
 	return stmt
 }
 
@@ -481,11 +815,155 @@ func (p *Parser) parseWhileLoop() *ast.WhileLoop {
 		return nil
 	}
 
+	p.loopDepth++
+	stmt.Body = p.parseBlockStatement()
+	p.loopDepth--
+
+	return stmt
+}
+
+func (p *Parser) parseForLoop() *ast.ForLoop {
+	stmt := &ast.ForLoop{Token: p.curToken}
+
+	// Handle "feast for" - like while loops, both "feast for" and just "for" are allowed
+	if p.curTokenIs(token.FEAST_WHILE) {
+		p.nextToken() // consume 'feast', now on 'for'
+	}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.Variable = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	if !p.expectPeek(token.IN) {
+		return nil
+	}
+
+	p.nextToken()
+	stmt.Iterable = p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.COLON) {
+		return nil
+	}
+
+	// The loop variable lives in the loop's scope and may shadow a constant
+	p.pushScope()
+	p.declare(stmt.Variable.Value, false)
+	p.loopDepth++
 	stmt.Body = p.parseBlockStatement()
+	p.loopDepth--
+	p.popScope()
 
 	return stmt
 }
 
+func (p *Parser) parseBreakStatement() *ast.BreakStatement {
+	if p.loopDepth == 0 {
+		p.loopControlError()
+		return nil
+	}
+	return &ast.BreakStatement{Token: p.curToken}
+}
+
+func (p *Parser) parseContinueStatement() *ast.ContinueStatement {
+	if p.loopDepth == 0 {
+		p.loopControlError()
+		return nil
+	}
+	return &ast.ContinueStatement{Token: p.curToken}
+}
+
+func (p *Parser) loopControlError() {
+	msg := fmt.Sprintf("[line %d, col %d] %s used outside of a loop",
+		p.curToken.Line, p.curToken.Column, p.curToken.Literal)
+	p.errors = append(p.errors, msg)
+}
+
+// parseTryStatement parses: try: body catch err: handler beef
+// Like if/else, a single beef closes the whole statement.
+func (p *Parser) parseTryStatement() *ast.TryStatement {
+	stmt := &ast.TryStatement{Token: p.curToken}
+
+	if !p.expectPeek(token.COLON) {
+		return nil
+	}
+
+	stmt.Body = p.parseBlockStatement()
+
+	// After parseBlockStatement(), we're sitting on the terminator
+	if !p.curTokenIs(token.CATCH) {
+		msg := fmt.Sprintf("[line %d, col %d] try block needs a catch clause",
+			stmt.Token.Line, stmt.Token.Column)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.ErrorName = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	if !p.expectPeek(token.COLON) {
+		return nil
+	}
+
+	// The error variable lives in the handler's scope
+	p.pushScope()
+	p.declare(stmt.ErrorName.Value, false)
+	stmt.Handler = p.parseBlockStatement()
+	p.popScope()
+
+	return stmt
+}
+
+func (p *Parser) parseSmiteStatement() *ast.SmiteStatement {
+	stmt := &ast.SmiteStatement{Token: p.curToken}
+
+	p.nextToken()
+	stmt.Value = p.parseExpression(LOWEST)
+
+	return stmt
+}
+
+func (p *Parser) pushScope() {
+	p.scopes = append(p.scopes, map[string]bool{})
+}
+
+func (p *Parser) popScope() {
+	p.scopes = p.scopes[:len(p.scopes)-1]
+}
+
+// declare records a name in the innermost scope; constant is true for pack
+func (p *Parser) declare(name string, constant bool) {
+	p.scopes[len(p.scopes)-1][name] = constant
+}
+
+// declareBinding declares the name bound by a prep, pack, praise,
+// congregation or wrangle statement. Declaring a name again in the scope
+// where it was packed is an error, and the name stays constant.
+func (p *Parser) declareBinding(name *ast.Identifier, constant bool) {
+	if p.scopes[len(p.scopes)-1][name.Value] {
+		msg := fmt.Sprintf("[line %d, col %d] cannot redeclare constant %s",
+			name.Token.Line, name.Token.Column, name.Value)
+		p.errors = append(p.errors, msg)
+		return
+	}
+	p.declare(name.Value, constant)
+}
+
+// isConstant reports whether name resolves to a pack declaration seen so far.
+// Names declared later (or in other files) are left to the evaluator.
+func (p *Parser) isConstant(name string) bool {
+	for i := len(p.scopes) - 1; i >= 0; i-- {
+		if constant, ok := p.scopes[i][name]; ok {
+			return constant
+		}
+	}
+	return false
+}
+
 func (p *Parser) parseWrangleStatement() *ast.WrangleStatement {
 	stmt := &ast.WrangleStatement{Token: p.curToken}
 
@@ -497,6 +975,36 @@ func (p *Parser) parseWrangleStatement() *ast.WrangleStatement {
 		Token: p.curToken,
 		Value: p.curToken.Literal,
 	}
+	p.declareBinding(stmt.ModuleName, false)
+
+	return stmt
+}
+
+// parseHerdStatement parses a module declaration: herd utils or herd utils(add, PI)
+func (p *Parser) parseHerdStatement() *ast.HerdStatement {
+	stmt := &ast.HerdStatement{Token: p.curToken}
+
+	// A module is a whole file, so herd only makes sense at the top level
+	if len(p.scopes) > 1 {
+		msg := fmt.Sprintf("[line %d, col %d] herd must be at the top level of a file",
+			p.curToken.Line, p.curToken.Column)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	if p.peekTokenIs(token.LPAREN) {
+		p.nextToken()
+		stmt.Exports = p.parseIdentifierList()
+		if stmt.Exports == nil {
+			return nil
+		}
+	}
 
 	return stmt
 }
@@ -518,3 +1026,159 @@ func (p *Parser) parseMemberAccessExpression(left ast.Expression) ast.Expression
 
 	return expr
 }
+
+func (p *Parser) parseArrayLiteral() ast.Expression {
+	array := &ast.ArrayLiteral{Token: p.curToken}
+	array.Elements = p.parseExpressionList(token.RBRACKET)
+	return array
+}
+
+func (p *Parser) parseHashLiteral() ast.Expression {
+	hash := &ast.HashLiteral{Token: p.curToken}
+	hash.Pairs = []*ast.HashLiteralPair{}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		p.nextToken()
+		keyToken := p.curToken
+		key := p.parseExpression(LOWEST)
+
+		if !p.expectPeek(token.COLON) {
+			return nil
+		}
+
+		p.nextToken()
+		value := p.parseExpression(LOWEST)
+
+		hash.Pairs = append(hash.Pairs, &ast.HashLiteralPair{Token: keyToken, Key: key, Value: value})
+
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.RBRACE) {
+		return nil
+	}
+
+	return hash
+}
+
+// parseExpressionList parses comma-separated expressions up to the end token.
+// A trailing comma is allowed, as in hash literals, so multi-line lists can
+// end every line with one.
+func (p *Parser) parseExpressionList(end token.TokenType) []ast.Expression {
+	list := []ast.Expression{}
+
+	if p.peekTokenIs(end) {
+		p.nextToken()
+		return list
+	}
+
+	p.nextToken()
+	list = append(list, p.parseExpression(LOWEST))
+
+	for p.peekTokenIs(token.COMMA) {
+		p.nextToken()
+		if p.peekTokenIs(end) {
+			break
+		}
+		p.nextToken()
+		list = append(list, p.parseExpression(LOWEST))
+	}
+
+	if !p.expectPeek(end) {
+		return nil
+	}
+
+	return list
+}
+
+func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
+	exp := &ast.IndexExpression{Token: p.curToken, Left: left}
+
+	p.nextToken()
+	exp.Index = p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.RBRACKET) {
+		return nil
+	}
+
+	return exp
+}
+
+func (p *Parser) parseIndexAssignmentStatement(target *ast.IndexExpression) ast.Statement {
+	p.nextToken() // move onto '='
+	stmt := &ast.IndexAssignmentStatement{Token: p.curToken, Target: target}
+
+	p.nextToken()
+	stmt.Value = p.parseExpression(LOWEST)
+
+	return stmt
+}
+
+func (p *Parser) parseMemberAssignmentStatement(target *ast.MemberAccessExpression) ast.Statement {
+	p.nextToken() // move onto '='
+	stmt := &ast.MemberAssignmentStatement{Token: p.curToken, Target: target}
+
+	p.nextToken()
+	stmt.Value = p.parseExpression(LOWEST)
+
+	return stmt
+}
+
+// parseStructDeclaration parses a record type: congregation Name(fields)
+// optionally followed by ':' and method declarations, closed by beef
+func (p *Parser) parseStructDeclaration() *ast.StructDeclaration {
+	stmt := &ast.StructDeclaration{Token: p.curToken}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	p.declareBinding(stmt.Name, false)
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	stmt.Fields = p.parseIdentifierList()
+	if stmt.Fields == nil {
+		return nil
+	}
+
+	// No colon means a plain record without methods
+	if !p.peekTokenIs(token.COLON) {
+		return stmt
+	}
+	p.nextToken()
+	p.nextToken()
+
+	// Method names belong to the type, not to the surrounding scope
+	p.pushScope()
+	defer p.popScope()
+
+	for !p.curTokenIs(token.BEEF) && !p.curTokenIs(token.EOF) {
+		if !p.curTokenIs(token.PRAISE) || !p.peekTokenIs(token.IDENT) {
+			msg := fmt.Sprintf("[line %d, col %d] congregation %s may only contain praise methods",
+				p.curToken.Line, p.curToken.Column, stmt.Name.Value)
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+
+		method := p.parseFunctionDeclaration()
+		if method == nil {
+			return nil
+		}
+		if len(method.Parameters) == 0 {
+			msg := fmt.Sprintf("[line %d, col %d] method %s must take the instance (self) as its first parameter",
+				method.Token.Line, method.Token.Column, method.Name.Value)
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+		stmt.Methods = append(stmt.Methods, method)
+		p.nextToken()
+	}
+
+	return stmt
+}
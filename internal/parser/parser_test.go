@@ -28,6 +28,24 @@ func TestParseIntegerLiteral(t *testing.T) {
 	assert.Equal(t, int64(42), intLiteral.Value)
 }
 
+func TestParseFloatLiteral(t *testing.T) {
+	input := "3.14"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1, "program should have 1 statement")
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	assert.True(t, ok, "statement should be *ast.ExpressionStatement")
+
+	floatLiteral, ok := stmt.Expression.(*ast.FloatLiteral)
+	assert.True(t, ok, "expression should be *ast.FloatLiteral, got %T", stmt.Expression)
+	assert.Equal(t, 3.14, floatLiteral.Value)
+}
+
 func TestParseIdentifier(t *testing.T) {
 	input := "foobar"
 	l := lexer.New(input)
@@ -91,6 +109,60 @@ func TestParseStringLiteral(t *testing.T) {
 	assert.Equal(t, "Hello, Beef!", strLiteral.Value)
 }
 
+func TestParseInterpolatedString(t *testing.T) {
+	input := `prep s = "total: {sum + 1}!"`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt, ok := program.Statements[0].(*ast.VariableDeclaration)
+	assert.True(t, ok, "statement should be *ast.VariableDeclaration")
+
+	str, ok := stmt.Value.(*ast.InterpolatedString)
+	assert.True(t, ok, "value should be *ast.InterpolatedString, got %T", stmt.Value)
+	assert.Len(t, str.Parts, 3)
+
+	text, ok := str.Parts[0].(*ast.StringLiteral)
+	assert.True(t, ok, "first part should be *ast.StringLiteral")
+	assert.Equal(t, "total: ", text.Value)
+
+	infix, ok := str.Parts[1].(*ast.InfixExpression)
+	assert.True(t, ok, "second part should be *ast.InfixExpression, got %T", str.Parts[1])
+	assert.Equal(t, "+", infix.Operator)
+
+	// Embedded tokens keep their position in the file
+	assert.Equal(t, 1, infix.Token.Line)
+	assert.Equal(t, 23, infix.Token.Column)
+	ident, ok := infix.Left.(*ast.Identifier)
+	assert.True(t, ok, "left operand should be *ast.Identifier")
+	assert.Equal(t, 19, ident.Token.Column)
+
+	last, ok := str.Parts[2].(*ast.StringLiteral)
+	assert.True(t, ok, "last part should be *ast.StringLiteral")
+	assert.Equal(t, "!", last.Value)
+}
+
+func TestParseInterpolationErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`prep a = "x {1 2}"`, "[line 1, col 16] unexpected 2 in string interpolation"},
+		{`prep b = "y {}"`, "[line 1, col 13] empty interpolation {} in string literal"},
+		{"prep c = \"z\n{1 +}\"", "[line 2, col 5] no prefix parse function for EOF found"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
 func TestParsePrefixExpression(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -177,6 +249,88 @@ func TestParseVariableDeclaration(t *testing.T) {
 	testIntegerLiteral(t, varDecl.Value, 5)
 }
 
+func TestParseConstantDeclaration(t *testing.T) {
+	input := "pack x = 5"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	constDecl, ok := program.Statements[0].(*ast.ConstantDeclaration)
+	assert.True(t, ok, "statement should be *ast.ConstantDeclaration")
+	assert.Equal(t, "x", constDecl.Name.Value)
+
+	testIntegerLiteral(t, constDecl.Value, 5)
+}
+
+func TestParseConstantReassignment(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"pack x = 1\nx = 2", "[line 2, col 1] cannot reassign constant x"},
+		{"pack limit = 10\nif true:\n   limit = 20\nbeef", "[line 3, col 4] cannot reassign constant limit"},
+		{"pack total = 0\npraise add(n):\n   total = total + n\nbeef", "[line 3, col 4] cannot reassign constant total"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseConstantRedeclaration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"pack x = 1\nprep x = 2", "[line 2, col 6] cannot redeclare constant x"},
+		{"pack x = 1\npack x = 2", "[line 2, col 6] cannot redeclare constant x"},
+		{"pack x = 1\npraise x(): serve 2 beef", "[line 2, col 8] cannot redeclare constant x"},
+		{"pack x = 1\ncongregation x(a)", "[line 2, col 14] cannot redeclare constant x"},
+		{"pack io = 5\nwrangle io", "[line 2, col 9] cannot redeclare constant io"},
+		// The name stays constant after the failed redeclaration
+		{"pack x = 1\nprep x = 2\nx = 3", "[line 3, col 1] cannot reassign constant x"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseShadowedConstantIsAssignable(t *testing.T) {
+	tests := []string{
+		// prep in an inner block shadows the constant
+		"pack x = 1\nif true:\n   prep x = 2\n   x = 3\nbeef",
+		// Parameters shadow the constant
+		"pack x = 1\npraise f(x):\n   x = x + 1\nbeef",
+		// Loop variables shadow the constant
+		"pack x = 1\nfeast for x in [1, 2]:\n   x = 0\nbeef",
+		// The constant's scope ends with its block
+		"prep x = 0\nif true:\n   pack x = 1\nbeef\nx = 2",
+		// A function or congregation in an inner block may reuse the name
+		"pack x = 1\nif true:\n   praise x(): serve 2 beef\n   congregation x(a)\nbeef",
+	}
+
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Empty(t, p.Errors(), "Input: %s", input)
+	}
+}
+
 func TestParseAssignmentStatement(t *testing.T) {
 	input := "x = 10"
 	l := lexer.New(input)
@@ -321,6 +475,69 @@ func TestParseIfElseStatementOneLine(t *testing.T) {
 	assert.Equal(t, int64(20), intLit.Value)
 }
 
+func TestParseElseIfChain(t *testing.T) {
+	input := `if x < 5:
+   prep a = 1
+else if x < 10:
+   prep b = 2
+else if x < 20:
+   prep c = 3
+else:
+   prep d = 4
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1, "the whole chain should be one statement")
+
+	ifStmt, ok := program.Statements[0].(*ast.IfStatement)
+	assert.True(t, ok, "statement should be *ast.IfStatement")
+	assert.Len(t, ifStmt.Consequence.Statements, 1)
+
+	assert.Len(t, ifStmt.ElseIfs, 2, "should have two else-if clauses")
+	for _, clause := range ifStmt.ElseIfs {
+		cond, ok := clause.Condition.(*ast.InfixExpression)
+		assert.True(t, ok, "else-if condition should be *ast.InfixExpression")
+		assert.Equal(t, "<", cond.Operator)
+		assert.Len(t, clause.Consequence.Statements, 1)
+	}
+	testIntegerLiteral(t, ifStmt.ElseIfs[1].Condition.(*ast.InfixExpression).Right, 20)
+
+	assert.NotNil(t, ifStmt.Alternative, "should have final else block")
+	assert.Len(t, ifStmt.Alternative.Statements, 1)
+}
+
+func TestParseElseIfWithoutElse(t *testing.T) {
+	input := "if a: 1 else if b: 2 beef"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	ifStmt := program.Statements[0].(*ast.IfStatement)
+	assert.Len(t, ifStmt.ElseIfs, 1)
+	assert.Nil(t, ifStmt.Alternative, "should have no else block")
+}
+
+func TestParseHeresyAlias(t *testing.T) {
+	input := "if a: 1 heresy if b: 2 heresy: 3 beef"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	ifStmt := program.Statements[0].(*ast.IfStatement)
+	assert.Len(t, ifStmt.ElseIfs, 1)
+	assert.NotNil(t, ifStmt.Alternative)
+}
+
 func TestParseWhileLoop(t *testing.T) {
 	input := `feast while x > 0:
    x = x - 1
@@ -341,6 +558,66 @@ beef`
 	assert.Len(t, whileLoop.Body.Statements, 1, "body should have 1 statement")
 }
 
+func TestParseForLoop(t *testing.T) {
+	tests := []struct {
+		input        string
+		tokenLiteral string
+	}{
+		{"feast for i in range(0, 10):\n   io.preach(i)\nbeef", "feast"},
+		{"for i in range(0, 10):\n   io.preach(i)\nbeef", "for"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		assert.Len(t, program.Statements, 1)
+
+		loop, ok := program.Statements[0].(*ast.ForLoop)
+		assert.True(t, ok, "statement should be *ast.ForLoop, got %T", program.Statements[0])
+		assert.Equal(t, tt.tokenLiteral, loop.TokenLiteral())
+		assert.Equal(t, "i", loop.Variable.Value)
+
+		call, ok := loop.Iterable.(*ast.FunctionCall)
+		assert.True(t, ok, "iterable should be *ast.FunctionCall")
+		assert.Len(t, call.Arguments, 2)
+		assert.Len(t, loop.Body.Statements, 1)
+	}
+}
+
+func TestParseForLoopAllowsLoopControl(t *testing.T) {
+	input := `feast for x in items:
+   if x == 0:
+      repent
+   beef
+   sacrifice
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	p.ParseProgram()
+	checkParserErrors(t, p)
+}
+
+func TestParseMalformedForLoop(t *testing.T) {
+	tests := []string{
+		"feast for in items: beef",
+		"feast for x items: beef",
+		"feast for x in items beef",
+	}
+
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.NotEmpty(t, p.Errors(), "Input: %s should produce parser errors", input)
+	}
+}
+
 func TestParseFunctionDeclaration(t *testing.T) {
 	input := `praise add(x, y):
    serve x + y
@@ -363,6 +640,186 @@ beef`
 	assert.NotNil(t, fnDecl.Body)
 }
 
+func TestParseDefaultParameters(t *testing.T) {
+	input := `praise greet(name, greeting = "Braised be", times = 1 + 1):
+   serve greeting
+beef
+prep f = praise(x, y = x): serve y beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	fnDecl, ok := program.Statements[0].(*ast.FunctionDeclaration)
+	assert.True(t, ok, "statement should be *ast.FunctionDeclaration")
+	assert.Len(t, fnDecl.Parameters, 3)
+	assert.Len(t, fnDecl.Defaults, 3)
+	assert.Nil(t, fnDecl.Defaults[0])
+
+	greeting, ok := fnDecl.Defaults[1].(*ast.StringLiteral)
+	assert.True(t, ok, "default should be *ast.StringLiteral, got %T", fnDecl.Defaults[1])
+	assert.Equal(t, "Braised be", greeting.Value)
+
+	_, ok = fnDecl.Defaults[2].(*ast.InfixExpression)
+	assert.True(t, ok, "default should be *ast.InfixExpression, got %T", fnDecl.Defaults[2])
+
+	decl := program.Statements[1].(*ast.VariableDeclaration)
+	fnLit, ok := decl.Value.(*ast.FunctionLiteral)
+	assert.True(t, ok, "value should be *ast.FunctionLiteral")
+	assert.Nil(t, fnLit.Defaults[0])
+	assert.NotNil(t, fnLit.Defaults[1])
+}
+
+func TestParseRestParameter(t *testing.T) {
+	input := `praise log(level, ...parts): serve parts beef
+prep f = praise(...all): serve all beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	fnDecl, ok := program.Statements[0].(*ast.FunctionDeclaration)
+	assert.True(t, ok, "statement should be *ast.FunctionDeclaration")
+	assert.Len(t, fnDecl.Parameters, 1)
+	assert.NotNil(t, fnDecl.Rest)
+	assert.Equal(t, "parts", fnDecl.Rest.Value)
+
+	fnLit := program.Statements[1].(*ast.VariableDeclaration).Value.(*ast.FunctionLiteral)
+	assert.Len(t, fnLit.Parameters, 0)
+	assert.Equal(t, "all", fnLit.Rest.Value)
+}
+
+func TestParseKeywordArguments(t *testing.T) {
+	input := `connect("db", host = "x", port = 1 + 1)`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	call, ok := stmt.Expression.(*ast.FunctionCall)
+	assert.True(t, ok, "expression should be *ast.FunctionCall")
+	assert.Len(t, call.Arguments, 1)
+	assert.Len(t, call.Keywords, 2)
+
+	assert.Equal(t, "host", call.Keywords[0].Name.Value)
+	host, ok := call.Keywords[0].Value.(*ast.StringLiteral)
+	assert.True(t, ok, "keyword value should be *ast.StringLiteral")
+	assert.Equal(t, "x", host.Value)
+
+	assert.Equal(t, "port", call.Keywords[1].Name.Value)
+	assert.Equal(t, 27, call.Keywords[1].Token.Column)
+	_, ok = call.Keywords[1].Value.(*ast.InfixExpression)
+	assert.True(t, ok, "keyword value should be *ast.InfixExpression")
+}
+
+func TestParseMalformedParameters(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"praise f(a = 1, b): serve a beef", "[line 1, col 17] parameter b without a default cannot follow parameters with defaults"},
+		{"praise f(1): serve 1 beef", "[line 1, col 10] expected next token to be IDENT, got INT instead"},
+		{"herd utils(a = 1)", "[line 1, col 14] expected next token to be ), got = instead"},
+		{"congregation Steak(cut = 1)", "[line 1, col 24] expected next token to be ), got = instead"},
+		{"praise f(...rest, a): serve a beef", "[line 1, col 13] rest parameter ...rest must be the last parameter"},
+		{"praise f(...rest = 1): serve 1 beef", "[line 1, col 13] rest parameter ...rest must be the last parameter"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseMalformedCallArguments(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"f(a = 1, 2)", "[line 1, col 10] positional argument cannot follow keyword arguments"},
+		{"f(a = 1, a = 2)", "[line 1, col 10] duplicate keyword argument a"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseFunctionLiteral(t *testing.T) {
+	input := `prep double = praise(x): serve x * 2 beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	decl, ok := program.Statements[0].(*ast.VariableDeclaration)
+	assert.True(t, ok, "statement should be *ast.VariableDeclaration")
+
+	fnLit, ok := decl.Value.(*ast.FunctionLiteral)
+	assert.True(t, ok, "value should be *ast.FunctionLiteral")
+	assert.Len(t, fnLit.Parameters, 1)
+	assert.Equal(t, "x", fnLit.Parameters[0].Value)
+	assert.Len(t, fnLit.Body.Statements, 1)
+}
+
+func TestParseFunctionLiteralAsArgument(t *testing.T) {
+	input := `apply(praise(a, b):
+   serve a + b
+beef, 1)`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	assert.True(t, ok, "statement should be *ast.ExpressionStatement")
+
+	callExp, ok := stmt.Expression.(*ast.FunctionCall)
+	assert.True(t, ok, "expression should be *ast.FunctionCall")
+	assert.Len(t, callExp.Arguments, 2)
+
+	fnLit, ok := callExp.Arguments[0].(*ast.FunctionLiteral)
+	assert.True(t, ok, "first argument should be *ast.FunctionLiteral")
+	assert.Len(t, fnLit.Parameters, 2)
+}
+
+func TestParseImmediatelyInvokedFunctionLiteral(t *testing.T) {
+	input := `praise(x): serve x + 1 beef(41)`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	assert.True(t, ok, "statement should be *ast.ExpressionStatement")
+
+	callExp, ok := stmt.Expression.(*ast.FunctionCall)
+	assert.True(t, ok, "expression should be *ast.FunctionCall")
+
+	_, ok = callExp.Function.(*ast.FunctionLiteral)
+	assert.True(t, ok, "callee should be *ast.FunctionLiteral")
+}
+
 func TestParseFunctionCall(t *testing.T) {
 	input := "preach(42)"
 	l := lexer.New(input)
@@ -448,12 +905,52 @@ func TestParseWrangleStatement(t *testing.T) {
 	assert.Equal(t, "io", stmt.ModuleName.Value)
 }
 
-func TestParseMemberAccessExpression(t *testing.T) {
-	input := "io.preach"
-	l := lexer.New(input)
-	p := New(l)
-
-	program := p.ParseProgram()
+func TestParseHerdStatement(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedName    string
+		expectedExports []string
+	}{
+		{"herd utils", "utils", nil},
+		{"herd utils(add, PI)", "utils", []string{"add", "PI"}},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		assert.Len(t, program.Statements, 1, "Input: %s", tt.input)
+
+		stmt, ok := program.Statements[0].(*ast.HerdStatement)
+		assert.True(t, ok, "statement should be *ast.HerdStatement, got %T", program.Statements[0])
+		assert.Equal(t, tt.expectedName, stmt.Name.Value)
+
+		var exports []string
+		for _, export := range stmt.Exports {
+			exports = append(exports, export.Value)
+		}
+		assert.Equal(t, tt.expectedExports, exports, "Input: %s", tt.input)
+	}
+}
+
+func TestParseHerdOutsideTopLevel(t *testing.T) {
+	input := "praise f():\n   herd utils\nbeef"
+	l := lexer.New(input)
+	p := New(l)
+	p.ParseProgram()
+
+	assert.Contains(t, p.Errors(), "[line 2, col 4] herd must be at the top level of a file")
+}
+
+func TestParseMemberAccessExpression(t *testing.T) {
+	input := "io.preach"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
 	checkParserErrors(t, p)
 
 	assert.Len(t, program.Statements, 1, "program should have 1 statement")
@@ -504,3 +1001,585 @@ func TestParseModuleFunctionCall(t *testing.T) {
 	assert.Len(t, call.Arguments, 1, "should have 1 argument")
 	testIntegerLiteral(t, call.Arguments[0], 42)
 }
+
+// ========================================
+// Arrays and Indexing Tests
+// ========================================
+
+func TestParsingArrayLiterals(t *testing.T) {
+	input := "[1, 2 * 2, 3 + 3]"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	assert.True(t, ok, "statement should be *ast.ExpressionStatement")
+
+	array, ok := stmt.Expression.(*ast.ArrayLiteral)
+	assert.True(t, ok, "expression should be *ast.ArrayLiteral, got %T", stmt.Expression)
+	assert.Len(t, array.Elements, 3)
+
+	testIntegerLiteral(t, array.Elements[0], 1)
+
+	infix, ok := array.Elements[1].(*ast.InfixExpression)
+	assert.True(t, ok, "second element should be *ast.InfixExpression")
+	assert.Equal(t, "*", infix.Operator)
+}
+
+func TestParsingEmptyArrayLiterals(t *testing.T) {
+	input := "[]"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	array, ok := stmt.Expression.(*ast.ArrayLiteral)
+	assert.True(t, ok, "expression should be *ast.ArrayLiteral, got %T", stmt.Expression)
+	assert.Len(t, array.Elements, 0)
+}
+
+func TestParsingArrayLiteralTrailingComma(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"[1, 2,]", 2},
+		{"[1,]", 1},
+		{"[\n   \"ribeye\",\n   \"brisket\",\n]", 2},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+		array, ok := stmt.Expression.(*ast.ArrayLiteral)
+		assert.True(t, ok, "expression should be *ast.ArrayLiteral, got %T", stmt.Expression)
+		assert.Len(t, array.Elements, tt.expected, "Input: %s", tt.input)
+	}
+
+	// A comma still needs an element before it
+	for _, input := range []string{"[,]", "[1,,]"} {
+		p := New(lexer.New(input))
+		p.ParseProgram()
+
+		assert.NotEmpty(t, p.Errors(), "Input: %s should produce parser errors", input)
+	}
+}
+
+func TestParsingIndexExpressions(t *testing.T) {
+	input := "numbers[1 + 1]"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	index, ok := stmt.Expression.(*ast.IndexExpression)
+	assert.True(t, ok, "expression should be *ast.IndexExpression, got %T", stmt.Expression)
+
+	ident, ok := index.Left.(*ast.Identifier)
+	assert.True(t, ok, "left should be *ast.Identifier")
+	assert.Equal(t, "numbers", ident.Value)
+
+	_, ok = index.Index.(*ast.InfixExpression)
+	assert.True(t, ok, "index should be *ast.InfixExpression")
+}
+
+func TestParsingNestedIndexExpressions(t *testing.T) {
+	input := "grid[0][1]"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	outer, ok := stmt.Expression.(*ast.IndexExpression)
+	assert.True(t, ok, "expression should be *ast.IndexExpression")
+	testIntegerLiteral(t, outer.Index, 1)
+
+	inner, ok := outer.Left.(*ast.IndexExpression)
+	assert.True(t, ok, "left should be *ast.IndexExpression")
+	testIntegerLiteral(t, inner.Index, 0)
+}
+
+func TestParsingIndexOnCallResult(t *testing.T) {
+	input := "io.read()[0]"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	index, ok := stmt.Expression.(*ast.IndexExpression)
+	assert.True(t, ok, "expression should be *ast.IndexExpression, got %T", stmt.Expression)
+
+	_, ok = index.Left.(*ast.FunctionCall)
+	assert.True(t, ok, "left should be *ast.FunctionCall, got %T", index.Left)
+}
+
+func TestParsingIndexAssignment(t *testing.T) {
+	input := "numbers[0] = 99"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	assign, ok := program.Statements[0].(*ast.IndexAssignmentStatement)
+	assert.True(t, ok, "statement should be *ast.IndexAssignmentStatement, got %T", program.Statements[0])
+
+	ident, ok := assign.Target.Left.(*ast.Identifier)
+	assert.True(t, ok, "target should index an identifier")
+	assert.Equal(t, "numbers", ident.Value)
+	testIntegerLiteral(t, assign.Target.Index, 0)
+	testIntegerLiteral(t, assign.Value, 99)
+}
+
+func TestParsingArrayLiteralOnNewLine(t *testing.T) {
+	// A '[' starting a new line begins a new statement, not an index
+	input := `prep x = y
+[1, 2]`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 2)
+
+	varDecl, ok := program.Statements[0].(*ast.VariableDeclaration)
+	assert.True(t, ok, "first statement should be *ast.VariableDeclaration")
+	_, ok = varDecl.Value.(*ast.Identifier)
+	assert.True(t, ok, "declaration value should be a plain identifier")
+
+	stmt, ok := program.Statements[1].(*ast.ExpressionStatement)
+	assert.True(t, ok, "second statement should be *ast.ExpressionStatement")
+	_, ok = stmt.Expression.(*ast.ArrayLiteral)
+	assert.True(t, ok, "expression should be *ast.ArrayLiteral, got %T", stmt.Expression)
+}
+
+// ========================================
+// Hash Literal Tests
+// ========================================
+
+func TestParsingHashLiterals(t *testing.T) {
+	input := `{"one": 1, "two": 2, 3: 1 + 2}`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	hash, ok := stmt.Expression.(*ast.HashLiteral)
+	assert.True(t, ok, "expression should be *ast.HashLiteral, got %T", stmt.Expression)
+	assert.Len(t, hash.Pairs, 3)
+
+	// Pairs keep source order
+	key, ok := hash.Pairs[0].Key.(*ast.StringLiteral)
+	assert.True(t, ok, "first key should be *ast.StringLiteral")
+	assert.Equal(t, "one", key.Value)
+	testIntegerLiteral(t, hash.Pairs[0].Value, 1)
+
+	testIntegerLiteral(t, hash.Pairs[2].Key, 3)
+	// Each pair records where its key starts
+	assert.Equal(t, 2, hash.Pairs[0].Token.Column)
+	assert.Equal(t, 22, hash.Pairs[2].Token.Column)
+	_, ok = hash.Pairs[2].Value.(*ast.InfixExpression)
+	assert.True(t, ok, "third value should be *ast.InfixExpression")
+}
+
+func TestParsingEmptyHashLiteral(t *testing.T) {
+	input := "{}"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	hash, ok := stmt.Expression.(*ast.HashLiteral)
+	assert.True(t, ok, "expression should be *ast.HashLiteral, got %T", stmt.Expression)
+	assert.Len(t, hash.Pairs, 0)
+}
+
+func TestParsingHashIndexAssignment(t *testing.T) {
+	input := `prices["brisket"] = 20`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assign, ok := program.Statements[0].(*ast.IndexAssignmentStatement)
+	assert.True(t, ok, "statement should be *ast.IndexAssignmentStatement, got %T", program.Statements[0])
+	key, ok := assign.Target.Index.(*ast.StringLiteral)
+	assert.True(t, ok, "index should be *ast.StringLiteral")
+	assert.Equal(t, "brisket", key.Value)
+}
+
+func TestParsingMalformedHashLiteral(t *testing.T) {
+	tests := []string{
+		`{"a" 1}`,
+		`{"a": 1 "b": 2}`,
+		`{"a": 1`,
+	}
+
+	for _, input := range tests {
+		l := lexer.New(input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.NotEmpty(t, p.Errors(), "Input: %s should produce parser errors", input)
+	}
+}
+
+// ========================================
+// Logical Operator Tests
+// ========================================
+
+func TestParseLogicalOperators(t *testing.T) {
+	tests := []struct {
+		input    string
+		operator string
+	}{
+		{"a and b", "and"},
+		{"a or b", "or"},
+		{"a && b", "&&"},
+		{"a || b", "||"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+		exp, ok := stmt.Expression.(*ast.InfixExpression)
+		assert.True(t, ok, "expression should be *ast.InfixExpression for input: %s", tt.input)
+		assert.Equal(t, tt.operator, exp.Operator)
+	}
+}
+
+func TestParseLogicalOperatorPrecedence(t *testing.T) {
+	// a or b and c == d  =>  a or (b and (c == d))
+	input := "a or b and c == d"
+	l := lexer.New(input)
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	or, ok := stmt.Expression.(*ast.InfixExpression)
+	assert.True(t, ok)
+	assert.Equal(t, "or", or.Operator)
+
+	and, ok := or.Right.(*ast.InfixExpression)
+	assert.True(t, ok, "right side of 'or' should be the 'and' expression")
+	assert.Equal(t, "and", and.Operator)
+
+	eq, ok := and.Right.(*ast.InfixExpression)
+	assert.True(t, ok, "right side of 'and' should be the comparison")
+	assert.Equal(t, "==", eq.Operator)
+}
+
+func TestParseNotKeywordPrecedence(t *testing.T) {
+	// not x == 5 and y  =>  (not (x == 5)) and y
+	input := "not x == 5 and y"
+	l := lexer.New(input)
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	and, ok := stmt.Expression.(*ast.InfixExpression)
+	assert.True(t, ok, "top-level expression should be 'and', got %T", stmt.Expression)
+	assert.Equal(t, "and", and.Operator)
+
+	not, ok := and.Left.(*ast.PrefixExpression)
+	assert.True(t, ok, "left side of 'and' should be the 'not' expression")
+	assert.Equal(t, "not", not.Operator)
+
+	eq, ok := not.Right.(*ast.InfixExpression)
+	assert.True(t, ok, "'not' should apply to the whole comparison")
+	assert.Equal(t, "==", eq.Operator)
+}
+
+func TestParseGroupedExpression(t *testing.T) {
+	// (5 + 3) * 2  =>  the sum is the left operand of the product
+	input := "(5 + 3) * 2"
+	l := lexer.New(input)
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	product, ok := stmt.Expression.(*ast.InfixExpression)
+	assert.True(t, ok)
+	assert.Equal(t, "*", product.Operator)
+
+	sum, ok := product.Left.(*ast.InfixExpression)
+	assert.True(t, ok, "left side should be the grouped sum")
+	assert.Equal(t, "+", sum.Operator)
+	testIntegerLiteral(t, product.Right, 2)
+}
+
+// ========================================
+// Loop Control Tests
+// ========================================
+
+func TestParseLoopControlInsideLoop(t *testing.T) {
+	input := `feast while true:
+   if x > 5:
+      sacrifice
+   beef
+   repent
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	loop := program.Statements[0].(*ast.WhileLoop)
+	assert.Len(t, loop.Body.Statements, 2)
+
+	ifStmt := loop.Body.Statements[0].(*ast.IfStatement)
+	_, ok := ifStmt.Consequence.Statements[0].(*ast.BreakStatement)
+	assert.True(t, ok, "statement should be *ast.BreakStatement")
+
+	_, ok = loop.Body.Statements[1].(*ast.ContinueStatement)
+	assert.True(t, ok, "statement should be *ast.ContinueStatement")
+}
+
+func TestParseLoopControlOutsideLoop(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"sacrifice", "[line 1, col 1] sacrifice used outside of a loop"},
+		{"if true:\n   repent\nbeef", "[line 2, col 4] repent used outside of a loop"},
+		// A function body is a fresh context, even when declared inside a loop
+		{"feast while true:\n   praise f():\n      sacrifice\n   beef\nbeef", "[line 3, col 7] sacrifice used outside of a loop"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseLoopControlAfterNestedFunction(t *testing.T) {
+	// Loop depth is restored after a nested function declaration
+	input := `feast while true:
+   praise f():
+      serve 1
+   beef
+   sacrifice
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	p.ParseProgram()
+	checkParserErrors(t, p)
+}
+
+func TestParseTryStatement(t *testing.T) {
+	input := `try:
+   prep x = risky()
+   x + 1
+catch err:
+   smite err
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	stmt, ok := program.Statements[0].(*ast.TryStatement)
+	assert.True(t, ok, "statement should be *ast.TryStatement, got %T", program.Statements[0])
+	assert.Len(t, stmt.Body.Statements, 2)
+	assert.Equal(t, "err", stmt.ErrorName.Value)
+	assert.Len(t, stmt.Handler.Statements, 1)
+
+	smite, ok := stmt.Handler.Statements[0].(*ast.SmiteStatement)
+	assert.True(t, ok, "handler should contain *ast.SmiteStatement")
+	ident, ok := smite.Value.(*ast.Identifier)
+	assert.True(t, ok)
+	assert.Equal(t, "err", ident.Value)
+}
+
+func TestParseNestedTryStatement(t *testing.T) {
+	input := `try:
+   if ok:
+      try:
+         a()
+      catch inner:
+         b()
+      beef
+   beef
+catch outer:
+   c()
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+	stmt, ok := program.Statements[0].(*ast.TryStatement)
+	assert.True(t, ok)
+	assert.Equal(t, "outer", stmt.ErrorName.Value)
+}
+
+func TestParseMalformedTryStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"try:\n   a()\nbeef", "[line 1, col 1] try block needs a catch clause"},
+		{"try:\n   a()\ncatch:\n   b()\nbeef", "[line 3, col 6] expected next token to be IDENT, got : instead"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseStructDeclaration(t *testing.T) {
+	input := `congregation Steak(cut, weight):
+   praise describe(self):
+      serve self.cut
+   beef
+
+   praise grill(self, minutes):
+      self.weight = self.weight - minutes
+   beef
+beef`
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	decl, ok := program.Statements[0].(*ast.StructDeclaration)
+	assert.True(t, ok, "statement should be *ast.StructDeclaration, got %T", program.Statements[0])
+	assert.Equal(t, "Steak", decl.Name.Value)
+	assert.Len(t, decl.Fields, 2)
+	assert.Equal(t, "cut", decl.Fields[0].Value)
+	assert.Equal(t, "weight", decl.Fields[1].Value)
+	assert.Len(t, decl.Methods, 2)
+	assert.Equal(t, "describe", decl.Methods[0].Name.Value)
+	assert.Equal(t, "grill", decl.Methods[1].Name.Value)
+
+	assign, ok := decl.Methods[1].Body.Statements[0].(*ast.MemberAssignmentStatement)
+	assert.True(t, ok, "method body should start with *ast.MemberAssignmentStatement")
+	assert.Equal(t, "weight", assign.Target.Member.Value)
+}
+
+func TestParseStructDeclarationWithoutMethods(t *testing.T) {
+	input := "congregation Point(x, y)\nprep p = Point(1, 2)"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 2)
+
+	decl, ok := program.Statements[0].(*ast.StructDeclaration)
+	assert.True(t, ok, "statement should be *ast.StructDeclaration")
+	assert.Len(t, decl.Fields, 2)
+	assert.Empty(t, decl.Methods)
+}
+
+func TestParseMemberAssignment(t *testing.T) {
+	input := "point.x = point.x + 1"
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	assert.Len(t, program.Statements, 1)
+
+	stmt, ok := program.Statements[0].(*ast.MemberAssignmentStatement)
+	assert.True(t, ok, "statement should be *ast.MemberAssignmentStatement, got %T", program.Statements[0])
+
+	obj, ok := stmt.Target.Object.(*ast.Identifier)
+	assert.True(t, ok)
+	assert.Equal(t, "point", obj.Value)
+	assert.Equal(t, "x", stmt.Target.Member.Value)
+
+	_, ok = stmt.Value.(*ast.InfixExpression)
+	assert.True(t, ok, "value should be *ast.InfixExpression")
+}
+
+func TestParseMalformedStructDeclaration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"congregation Steak(cut):\n   prep x = 1\nbeef", "[line 2, col 4] congregation Steak may only contain praise methods"},
+		{"congregation Steak(cut):\n   praise describe():\n      serve 1\n   beef\nbeef", "[line 2, col 4] method describe must take the instance (self) as its first parameter"},
+		{"congregation (cut)", "[line 1, col 14] expected next token to be IDENT, got ( instead"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
+
+func TestParseIllegalTokens(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"prep s = \"oops", "[line 1, col 10] unterminated string literal"},
+		{"io.preach(\"bad \\q\")", "[line 1, col 16] invalid escape sequence \\q in string literal"},
+		{"prep x = 1 & 2", "[line 1, col 12] illegal character \"&\""},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		assert.Contains(t, p.Errors(), tt.expected, "Input: %s", tt.input)
+	}
+}
@@ -19,8 +19,11 @@ const (
 	// Identifiers and literals
 	IDENT  TokenType = "IDENT"  // variable names, function names
 	INT    TokenType = "INT"    // integer literals
+	FLOAT  TokenType = "FLOAT"  // floating point literals
 	STRING TokenType = "STRING" // string literals
 
+	INTERPOLATED TokenType = "INTERPOLATED" // string literals with {expr} parts
+
 	// Operators
 	ASSIGN   TokenType = "="
 	PLUS     TokenType = "+"
@@ -43,45 +46,72 @@ const (
 	NOT TokenType = "!"
 
 	// Delimiters
-	LPAREN TokenType = "("
-	RPAREN TokenType = ")"
-	COLON  TokenType = ":"
-	COMMA  TokenType = ","
-	DOT    TokenType = "."
+	LPAREN   TokenType = "("
+	RPAREN   TokenType = ")"
+	COLON    TokenType = ":"
+	COMMA    TokenType = ","
+	DOT      TokenType = "."
+	ELLIPSIS TokenType = "..." // rest parameters: praise log(level, ...parts)
+	LBRACKET TokenType = "["
+	RBRACKET TokenType = "]"
+	LBRACE   TokenType = "{"
+	RBRACE   TokenType = "}"
 
 	// Keywords
 	PRAISE      TokenType = "PRAISE"      // function declaration
 	BEEF        TokenType = "BEEF"        // block terminator
 	FEAST_WHILE TokenType = "FEAST_WHILE" // while loop
+	FOR         TokenType = "FOR"         // for-each loop (feast for x in ...)
+	IN          TokenType = "IN"          // separates the loop variable from what it loops over
 	IF          TokenType = "IF"
 	ELSE        TokenType = "ELSE"
-	PREP        TokenType = "PREP"    // variable declaration
-	SERVE       TokenType = "SERVE"   // return
-	WRANGLE     TokenType = "WRANGLE" // import module
-	HERD        TokenType = "HERD"    // module keyword
+	PREP        TokenType = "PREP"      // variable declaration
+	PACK        TokenType = "PACK"      // constant declaration
+	SERVE       TokenType = "SERVE"     // return
+	WRANGLE     TokenType = "WRANGLE"   // import module
+	HERD        TokenType = "HERD"      // module keyword
+	SACRIFICE   TokenType = "SACRIFICE" // break out of a loop
+	REPENT      TokenType = "REPENT"    // continue to the next loop iteration
+	TRY         TokenType = "TRY"       // start of a try/catch block
+	CATCH       TokenType = "CATCH"     // error handler of a try block
+	SMITE       TokenType = "SMITE"     // raise an error
 	TRUE        TokenType = "TRUE"
 	FALSE       TokenType = "FALSE"
 	AND_WORD    TokenType = "AND" // 'and' keyword
 	OR_WORD     TokenType = "OR"  // 'or' keyword
 	NOT_WORD    TokenType = "NOT" // 'not' keyword
+
+	// Record types
+	CONGREGATION TokenType = "CONGREGATION" // record type declaration
 )
 
 var keywords = map[string]TokenType{
-	"praise":  PRAISE,
-	"beef":    BEEF,
-	"feast":   FEAST_WHILE, // Will need special handling for "feast while"
-	"while":   FEAST_WHILE,
-	"if":      IF,
-	"else":    ELSE,
-	"prep":    PREP,
-	"serve":   SERVE,
-	"wrangle": WRANGLE,
-	"herd":    HERD,
-	"true":    TRUE,
-	"false":   FALSE,
-	"and":     AND_WORD,
-	"or":      OR_WORD,
-	"not":     NOT_WORD,
+	"praise":    PRAISE,
+	"beef":      BEEF,
+	"feast":     FEAST_WHILE, // Will need special handling for "feast while"
+	"while":     FEAST_WHILE,
+	"for":       FOR,
+	"in":        IN,
+	"if":        IF,
+	"else":      ELSE,
+	"heresy":    ELSE, // beef-themed alias for else
+	"prep":      PREP,
+	"pack":      PACK,
+	"serve":     SERVE,
+	"wrangle":   WRANGLE,
+	"herd":      HERD,
+	"sacrifice": SACRIFICE,
+	"repent":    REPENT,
+	"try":       TRY,
+	"catch":     CATCH,
+	"smite":     SMITE,
+	"true":      TRUE,
+	"false":     FALSE,
+	"and":       AND_WORD,
+	"or":        OR_WORD,
+	"not":       NOT_WORD,
+
+	"congregation": CONGREGATION,
 }
 
 // LookupIdent checks if an identifier is a keyword
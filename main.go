@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/elitwilson/beeflang/internal/evaluator"
 	"github.com/elitwilson/beeflang/internal/lexer"
@@ -68,9 +69,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Modules are looked up next to the program first, then in BEEFPATH
+	if beefPath := os.Getenv("BEEFPATH"); beefPath != "" {
+		evaluator.SearchPaths = filepath.SplitList(beefPath)
+	}
+
 	// Evaluate the program (this loads all function/variable declarations)
 	env := object.NewEnvironment()
-	result := evaluator.Eval(program, env)
+	env.SetFile(filename)
+	result := safely(func() object.Object { return evaluator.Eval(program, env) })
 
 	// Check for errors during program evaluation
 	if result != nil && result.Type() == "ERROR" {
@@ -81,10 +88,8 @@ func main() {
 	// Auto-call ChurchOfBeef() if it exists (entry point function)
 	if entryPoint, ok := env.Get("ChurchOfBeef"); ok {
 		if fn, ok := entryPoint.(*object.Function); ok {
-			// Create new environment for ChurchOfBeef() execution
-			entryEnv := object.NewEnclosedEnvironment(fn.Env)
-			// Execute ChurchOfBeef() body
-			result := evaluator.Eval(fn.Body, entryEnv)
+			// Execute ChurchOfBeef() as the outermost call of stack traces
+			result := safely(func() object.Object { return evaluator.CallEntryPoint(fn) })
 
 			// Check for errors during ChurchOfBeef() execution
 			if result != nil && result.Type() == "ERROR" {
@@ -100,3 +105,14 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// safely runs part of the program, turning a Go panic inside the interpreter
+// into an error report instead of a crash with a Go stack trace
+func safely(run func() object.Object) (result object.Object) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = &object.Error{Message: fmt.Sprintf("internal interpreter error: %v", r)}
+		}
+	}()
+	return run()
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/elitwilson/beeflang/internal/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafelyRecoversFromPanics(t *testing.T) {
+	result := safely(func() object.Object {
+		var instance *object.Instance
+		return instance.Fields["cut"] // nil pointer dereference
+	})
+
+	errObj, ok := result.(*object.Error)
+	assert.True(t, ok, "Expected error object, got %T", result)
+	if ok {
+		assert.Contains(t, errObj.Message, "internal interpreter error: runtime error: invalid memory address")
+	}
+}
+
+func TestSafelyReturnsResult(t *testing.T) {
+	result := safely(func() object.Object { return &object.Integer{Value: 42} })
+
+	assert.Equal(t, "42", result.Inspect())
+}